@@ -7,6 +7,6 @@ variable "solarwinds_user" {
   description = "The solarwinds user to use"
 }
 
-variable "solarwinds_passwd" {
+variable "solarwinds_password" {
   description = "The solarwinds password to use"
 }
\ No newline at end of file
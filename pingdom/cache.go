@@ -0,0 +1,47 @@
+package pingdom
+
+import (
+	"sync"
+)
+
+// idCache memoises the IDs returned by a List call, so that the existence
+// checks made while refreshing many resources only need a single listing.
+// It is safe for concurrent use; concurrent lookups wait for the same listing.
+type idCache struct {
+	list func() ([]int, error)
+
+	mu  sync.Mutex
+	ids map[int]bool
+}
+
+func newIDCache(list func() ([]int, error)) *idCache {
+	return &idCache{list: list}
+}
+
+// Exists reports whether the ID was present in the listing, loading it first
+// if the cache is empty.
+func (c *idCache) Exists(id int) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ids == nil {
+		ids, err := c.list()
+		if err != nil {
+			return false, err
+		}
+		c.ids = make(map[int]bool, len(ids))
+		for _, id := range ids {
+			c.ids[id] = true
+		}
+	}
+
+	return c.ids[id], nil
+}
+
+// Invalidate drops the cached listing, the next lookup lists again.
+func (c *idCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ids = nil
+}
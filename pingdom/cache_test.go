@@ -0,0 +1,67 @@
+package pingdom
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestIDCacheListsOnce(t *testing.T) {
+	var calls int32
+	cache := newIDCache(func() ([]int, error) {
+		atomic.AddInt32(&calls, 1)
+		return []int{1, 2, 3}, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			exists, err := cache.Exists(id)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if want := id >= 1 && id <= 3; exists != want {
+				t.Errorf("Exists(%d) = %t, want %t", id, exists, want)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("expected a single listing, got %d", calls)
+	}
+
+	cache.Invalidate()
+	if _, err := cache.Exists(1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected a new listing after invalidation, got %d", calls)
+	}
+}
+
+func TestIDCacheDoesNotCacheErrors(t *testing.T) {
+	fail := true
+	cache := newIDCache(func() ([]int, error) {
+		if fail {
+			return nil, errors.New("429 Too Many Requests")
+		}
+		return []int{42}, nil
+	})
+
+	if _, err := cache.Exists(42); err == nil {
+		t.Fatal("expected the listing error to be returned")
+	}
+
+	fail = false
+	exists, err := cache.Exists(42)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !exists {
+		t.Error("expected 42 to exist after a successful listing")
+	}
+}
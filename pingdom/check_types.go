@@ -0,0 +1,387 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+// The go-pingdom client only ships the http, ping, tcp and dns check types.
+// The remaining uptime check types are implemented here against its Check
+// interface, and their type specific settings are read with a raw request.
+
+// baseCheck holds the parameters shared by every check type.
+type baseCheck struct {
+	Name                     string
+	Hostname                 string
+	Resolution               int
+	Paused                   bool
+	ResponseTimeThreshold    int
+	SendNotificationWhenDown int
+	NotifyAgainEvery         int
+	NotifyWhenBackup         bool
+	IntegrationIds           []int
+	Tags                     string
+	ProbeFilters             string
+	UserIds                  []int
+	TeamIds                  []int
+}
+
+func (ck *baseCheck) putParams() map[string]string {
+	m := map[string]string{
+		"name":             ck.Name,
+		"host":             ck.Hostname,
+		"paused":           strconv.FormatBool(ck.Paused),
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"tags":             ck.Tags,
+		"probe_filters":    ck.ProbeFilters,
+		"userids":          intListToCDString(ck.UserIds),
+		"teamids":          intListToCDString(ck.TeamIds),
+	}
+
+	if ck.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(ck.Resolution)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
+	}
+
+	return m
+}
+
+func (ck *baseCheck) valid() error {
+	if ck.Name == "" {
+		return fmt.Errorf("invalid value for `Name`, must contain non-empty string")
+	}
+
+	if ck.Hostname == "" {
+		return fmt.Errorf("invalid value for `Hostname`, must contain non-empty string")
+	}
+
+	switch ck.Resolution {
+	case 0, 1, 5, 15, 30, 60:
+	default:
+		return fmt.Errorf("invalid value %v for `Resolution`, allowed values are [1,5,15,30,60]", ck.Resolution)
+	}
+
+	return nil
+}
+
+// postParams clears out the empty values of the PUT params, which the Pingdom
+// API rejects on creation, and sets the check type.
+func postParams(checkType string, params map[string]string) map[string]string {
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+	params["type"] = checkType
+	return params
+}
+
+func validPort(port int, required bool) error {
+	if port == 0 && !required {
+		return nil
+	}
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("Invalid value for `Port`.  Must contain an integer >= 1 and <= 65535")
+	}
+	return nil
+}
+
+// smtpCheck represents a Pingdom SMTP check.
+type smtpCheck struct {
+	baseCheck
+	Port           int
+	Username       string
+	Password       string
+	Encryption     bool
+	StringToExpect string
+}
+
+// PutParams returns a map of parameters for an smtpCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *smtpCheck) PutParams() map[string]string {
+	m := ck.putParams()
+	m["encryption"] = strconv.FormatBool(ck.Encryption)
+
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	if ck.Username != "" {
+		m["auth"] = fmt.Sprintf("%s:%s", ck.Username, ck.Password)
+	}
+
+	if ck.StringToExpect != "" {
+		m["stringtoexpect"] = ck.StringToExpect
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for an smtpCheck that can be sent along
+// with an HTTP POST request.
+func (ck *smtpCheck) PostParams() map[string]string {
+	return postParams("smtp", ck.PutParams())
+}
+
+// Valid determines whether the smtpCheck contains valid fields.
+func (ck *smtpCheck) Valid() error {
+	if err := ck.valid(); err != nil {
+		return err
+	}
+	return validPort(ck.Port, false)
+}
+
+// mailboxCheck represents a Pingdom POP3 or IMAP check, which share the same
+// settings.
+type mailboxCheck struct {
+	baseCheck
+	Type           string
+	Port           int
+	Encryption     bool
+	StringToExpect string
+}
+
+// PutParams returns a map of parameters for a mailboxCheck that can be sent
+// along with an HTTP PUT request.
+func (ck *mailboxCheck) PutParams() map[string]string {
+	m := ck.putParams()
+	m["encryption"] = strconv.FormatBool(ck.Encryption)
+
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	if ck.StringToExpect != "" {
+		m["stringtoexpect"] = ck.StringToExpect
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a mailboxCheck that can be sent
+// along with an HTTP POST request.
+func (ck *mailboxCheck) PostParams() map[string]string {
+	return postParams(ck.Type, ck.PutParams())
+}
+
+// Valid determines whether the mailboxCheck contains valid fields.
+func (ck *mailboxCheck) Valid() error {
+	if err := ck.valid(); err != nil {
+		return err
+	}
+	if ck.Type != "pop3" && ck.Type != "imap" {
+		return fmt.Errorf("invalid mailbox check type '%s', allowed values are [pop3,imap]", ck.Type)
+	}
+	return validPort(ck.Port, false)
+}
+
+// udpCheck represents a Pingdom UDP check.
+type udpCheck struct {
+	baseCheck
+	Port           int
+	StringToSend   string
+	StringToExpect string
+}
+
+// PutParams returns a map of parameters for a udpCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *udpCheck) PutParams() map[string]string {
+	m := ck.putParams()
+	m["port"] = strconv.Itoa(ck.Port)
+	m["stringtosend"] = ck.StringToSend
+	m["stringtoexpect"] = ck.StringToExpect
+	return m
+}
+
+// PostParams returns a map of parameters for a udpCheck that can be sent along
+// with an HTTP POST request.
+func (ck *udpCheck) PostParams() map[string]string {
+	return postParams("udp", ck.PutParams())
+}
+
+// Valid determines whether the udpCheck contains valid fields. Pingdom requires
+// both a string to send and a string to expect for UDP checks.
+func (ck *udpCheck) Valid() error {
+	if err := ck.valid(); err != nil {
+		return err
+	}
+
+	if err := validPort(ck.Port, true); err != nil {
+		return err
+	}
+
+	if ck.StringToSend == "" {
+		return fmt.Errorf("invalid value for `StringToSend`, must contain non-empty string")
+	}
+
+	if ck.StringToExpect == "" {
+		return fmt.Errorf("invalid value for `StringToExpect`, must contain non-empty string")
+	}
+
+	return nil
+}
+
+// httpCustomCheck represents a Pingdom HTTP custom check, which polls a URL
+// returning an XML status document.
+type httpCustomCheck struct {
+	baseCheck
+	Url            string
+	Encryption     bool
+	Port           int
+	Username       string
+	Password       string
+	AdditionalUrls []string
+}
+
+// PutParams returns a map of parameters for an httpCustomCheck that can be sent
+// along with an HTTP PUT request.
+func (ck *httpCustomCheck) PutParams() map[string]string {
+	m := ck.putParams()
+	m["url"] = ck.Url
+	m["encryption"] = strconv.FormatBool(ck.Encryption)
+	m["additionalurls"] = strings.Join(ck.AdditionalUrls, ";")
+
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	if ck.Username != "" {
+		m["auth"] = fmt.Sprintf("%s:%s", ck.Username, ck.Password)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for an httpCustomCheck that can be
+// sent along with an HTTP POST request.
+func (ck *httpCustomCheck) PostParams() map[string]string {
+	return postParams("httpcustom", ck.PutParams())
+}
+
+// Valid determines whether the httpCustomCheck contains valid fields.
+func (ck *httpCustomCheck) Valid() error {
+	if err := ck.valid(); err != nil {
+		return err
+	}
+
+	if ck.Url == "" {
+		return fmt.Errorf("invalid value for `Url`, must contain non-empty string")
+	}
+
+	return validPort(ck.Port, false)
+}
+
+// isExtendedCheckType reports whether the check type is one of those
+// implemented in this file rather than by go-pingdom.
+func isExtendedCheckType(name string) bool {
+	switch name {
+	case "smtp", "pop3", "imap", "udp", "httpcustom":
+		return true
+	}
+	return false
+}
+
+// checkTypeDetails is the `type` object of a detailed check response, limited
+// to the check types go-pingdom does not decode.
+type checkTypeDetails struct {
+	SMTP       *checkSMTPDetails       `json:"smtp,omitempty"`
+	POP3       *checkMailboxDetails    `json:"pop3,omitempty"`
+	IMAP       *checkMailboxDetails    `json:"imap,omitempty"`
+	UDP        *checkUDPDetails        `json:"udp,omitempty"`
+	HTTPCustom *checkHTTPCustomDetails `json:"httpcustom,omitempty"`
+}
+
+// checkSMTPDetails represents the details specific to SMTP checks.
+type checkSMTPDetails struct {
+	Port           int    `json:"port,omitempty"`
+	Username       string `json:"username,omitempty"`
+	Password       string `json:"password,omitempty"`
+	Encryption     bool   `json:"encryption,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+}
+
+// checkMailboxDetails represents the details specific to POP3 and IMAP checks.
+type checkMailboxDetails struct {
+	Port           int    `json:"port,omitempty"`
+	Encryption     bool   `json:"encryption,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+}
+
+// checkUDPDetails represents the details specific to UDP checks.
+type checkUDPDetails struct {
+	Port           int    `json:"port,omitempty"`
+	StringToSend   string `json:"stringtosend,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+}
+
+// checkHTTPCustomDetails represents the details specific to HTTP custom checks.
+type checkHTTPCustomDetails struct {
+	Url            string   `json:"url,omitempty"`
+	Encryption     bool     `json:"encryption,omitempty"`
+	Port           int      `json:"port,omitempty"`
+	Username       string   `json:"username,omitempty"`
+	Password       string   `json:"password,omitempty"`
+	AdditionalUrls []string `json:"additionalurls,omitempty"`
+}
+
+// checkExtraDetails holds the fields of a detailed check response that
+// go-pingdom does not decode.
+type checkExtraDetails struct {
+	Type          checkTypeDetails `json:"type"`
+	LastDownStart int64            `json:"lastdownstart,omitempty"`
+	LastDownEnd   int64            `json:"lastdownend,omitempty"`
+}
+
+type checkDetailsJSONResponse struct {
+	Check json.RawMessage `json:"check"`
+}
+
+// readCheck returns the details of a check, both as decoded by go-pingdom and
+// the fields it leaves out, from a single request.
+func readCheck(client *pingdom.Client, id int) (*pingdom.CheckResponse, *checkExtraDetails, error) {
+	req, err := client.NewRequest("GET", "/checks/"+strconv.Itoa(id)+"?include_teams=true", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := &checkDetailsJSONResponse{}
+	if _, err := client.Do(req, m); err != nil {
+		return nil, nil, err
+	}
+
+	ck := &pingdom.CheckResponse{}
+	if err := json.Unmarshal(m.Check, ck); err != nil {
+		return nil, nil, err
+	}
+	ck.TeamIds = make([]int, len(ck.Teams))
+	for i := range ck.Teams {
+		ck.TeamIds[i] = ck.Teams[i].ID
+	}
+
+	extra := &checkExtraDetails{}
+	if err := json.Unmarshal(m.Check, extra); err != nil {
+		return nil, nil, err
+	}
+	return ck, extra, nil
+}
+
+func intListToCDString(integers []int) string {
+	s := make([]string, len(integers))
+	for i, item := range integers {
+		s[i] = strconv.Itoa(item)
+	}
+	return strings.Join(s, ",")
+}
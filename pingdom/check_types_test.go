@@ -0,0 +1,189 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func TestSMTPCheckParams(t *testing.T) {
+	check := smtpCheck{
+		baseCheck: baseCheck{
+			Name:       "mail",
+			Hostname:   "smtp.example.com",
+			Resolution: 5,
+			UserIds:    []int{1, 2},
+		},
+		Port:           587,
+		Username:       "user",
+		Password:       "secret",
+		StringToExpect: "220",
+	}
+
+	if err := check.Valid(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]string{
+		"name":             "mail",
+		"host":             "smtp.example.com",
+		"paused":           "false",
+		"notifyagainevery": "0",
+		"notifywhenbackup": "false",
+		"userids":          "1,2",
+		"resolution":       "5",
+		"encryption":       "false",
+		"port":             "587",
+		"auth":             "user:secret",
+		"stringtoexpect":   "220",
+		"type":             "smtp",
+	}
+	if got := check.PostParams(); !reflect.DeepEqual(got, want) {
+		t.Errorf("PostParams() = %v, want %v", got, want)
+	}
+}
+
+func TestMailboxCheckValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		check   mailboxCheck
+		wantErr bool
+	}{
+		{
+			name:  "pop3",
+			check: mailboxCheck{baseCheck: baseCheck{Name: "a", Hostname: "b"}, Type: "pop3", Port: 995},
+		},
+		{
+			name:  "imap without port",
+			check: mailboxCheck{baseCheck: baseCheck{Name: "a", Hostname: "b"}, Type: "imap"},
+		},
+		{
+			name:    "bad type",
+			check:   mailboxCheck{baseCheck: baseCheck{Name: "a", Hostname: "b"}, Type: "smtp"},
+			wantErr: true,
+		},
+		{
+			name:    "bad port",
+			check:   mailboxCheck{baseCheck: baseCheck{Name: "a", Hostname: "b"}, Type: "imap", Port: 70000},
+			wantErr: true,
+		},
+		{
+			name:    "bad resolution",
+			check:   mailboxCheck{baseCheck: baseCheck{Name: "a", Hostname: "b", Resolution: 7}, Type: "imap"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.check.Valid(); (err != nil) != tt.wantErr {
+				t.Errorf("Valid() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUDPCheckValid(t *testing.T) {
+	check := udpCheck{
+		baseCheck:      baseCheck{Name: "voip", Hostname: "sip.example.com"},
+		Port:           5060,
+		StringToSend:   "OPTIONS",
+		StringToExpect: "200",
+	}
+	if err := check.Valid(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	noPort := check
+	noPort.Port = 0
+	if err := noPort.Valid(); err == nil {
+		t.Error("expected an error for a missing port")
+	}
+
+	noExpect := check
+	noExpect.StringToExpect = ""
+	if err := noExpect.Valid(); err == nil {
+		t.Error("expected an error for a missing stringtoexpect")
+	}
+
+	if got := check.PostParams()["type"]; got != "udp" {
+		t.Errorf("PostParams()[type] = %s, want udp", got)
+	}
+}
+
+func TestHTTPCustomCheckParams(t *testing.T) {
+	check := httpCustomCheck{
+		baseCheck:      baseCheck{Name: "legacy", Hostname: "www.example.com"},
+		Url:            "/status.xml",
+		AdditionalUrls: []string{"www.example.org/status.xml", "www.example.net/status.xml"},
+	}
+	if err := check.Valid(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	put := check.PutParams()
+	if got, want := put["additionalurls"], "www.example.org/status.xml;www.example.net/status.xml"; got != want {
+		t.Errorf("PutParams()[additionalurls] = %s, want %s", got, want)
+	}
+	if _, ok := put["auth"]; ok {
+		t.Error("PutParams() should not contain auth without a username")
+	}
+
+	check.AdditionalUrls = nil
+	if _, ok := check.PostParams()["additionalurls"]; ok {
+		t.Error("PostParams() should not contain empty additionalurls")
+	}
+
+	check.Url = ""
+	if err := check.Valid(); err == nil {
+		t.Error("expected an error for a missing url")
+	}
+}
+
+func TestReadCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/checks/85975" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"check": {
+			"id": 85975,
+			"name": "mail",
+			"hostname": "smtp.example.com",
+			"status": "down",
+			"created": 1297446423,
+			"lasterrortime": 1297446412,
+			"lastdownstart": 1297446400,
+			"lastdownend": 1297446410,
+			"teams": [{"id": 7, "name": "ops"}],
+			"type": {"smtp": {"port": 587, "stringtoexpect": "220"}}
+		}}`)
+	}))
+	defer server.Close()
+
+	client, err := pingdom.NewClientWithConfig(pingdom.ClientConfig{APIToken: "token", BaseURL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ck, details, err := readCheck(client, 85975)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ck.Name != "mail" || ck.Status != "down" || ck.Type.Name != "smtp" || ck.LastErrorTime != 1297446412 {
+		t.Errorf("unexpected check %+v", ck)
+	}
+	if !reflect.DeepEqual(ck.TeamIds, []int{7}) {
+		t.Errorf("got team ids %v, want [7]", ck.TeamIds)
+	}
+	if details.LastDownStart != 1297446400 || details.LastDownEnd != 1297446410 {
+		t.Errorf("unexpected down times %+v", details)
+	}
+	if details.Type.SMTP == nil || details.Type.SMTP.Port != 587 || details.Type.SMTP.StringToExpect != "220" {
+		t.Errorf("unexpected smtp details %+v", details.Type.SMTP)
+	}
+}
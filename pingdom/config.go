@@ -1,10 +1,13 @@
 package pingdom
 
 import (
-	"errors"
+	"fmt"
 	"github.com/nordcloud/go-pingdom/solarwinds"
 	"log"
-	"os"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/nordcloud/go-pingdom/pingdom"
 	"github.com/nordcloud/go-pingdom/pingdomext"
@@ -16,69 +19,233 @@ type Config struct {
 	SolarwindsUser     string `mapstructure:"solarwinds_user"`
 	SolarwindsPassword string `mapstructure:"solarwinds_password"`
 	SolarwindsOrgID    string `mapstructure:"solarwinds_org_id"`
+	// SolarwindsPasswd is the deprecated name of SolarwindsPassword.
+	SolarwindsPasswd string `mapstructure:"solarwinds_passwd"`
+
+	// The credential sources used when the credentials aren't given.
+	APITokenFile           string `mapstructure:"api_token_file"`
+	SolarwindsPasswordFile string `mapstructure:"solarwinds_password_file"`
+	SharedCredentialsFile  string `mapstructure:"shared_credentials_file"`
+	Profile                string `mapstructure:"profile"`
+
+	EnforceCreditsForCreations bool   `mapstructure:"enforce_credits_for_creations"`
+	PingdomBaseURL             string `mapstructure:"pingdom_base_url"`
+	PingdomExtBaseURL          string `mapstructure:"pingdomext_base_url"`
+	SolarwindsBaseURL          string `mapstructure:"solarwinds_base_url"`
+	MaxRetries                 int    `mapstructure:"max_retries"`
+	MaxRetryWait               int    `mapstructure:"max_retry_wait"`
+	// MaxRequestsPerSecond caps the request rate of all the clients, 0 means
+	// no cap.
+	MaxRequestsPerSecond float64 `mapstructure:"max_requests_per_second"`
 }
 
 type Clients struct {
-	Pingdom    *pingdom.Client
-	PingdomExt *pingdomext.Client
-	Solarwinds *solarwinds.Client
+	Pingdom *pingdom.Client
+
+	// The SolarWinds and pingdomext clients log in on first use, so that
+	// configurations without SolarWinds resources don't need the credentials.
+	config     *Config
+	transport  http.RoundTripper
+	mu         sync.Mutex
+	pingdomExt *pingdomext.Client
+	solarwinds *solarwinds.Client
+
+	checks       *idCache
+	tmsChecks    *idCache
+	teams        *idCache
+	integrations *idCache
+
+	// quota is only set when the provider enforces credits for creations.
+	quota *creditQuota
+	// limiter paces the requests of all the clients.
+	limiter *rateLimiter
 }
 
 func (c *Config) Client() (*Clients, error) {
-	pingdomClient, err := c.pingdomClient()
+	limiter := newRateLimiter(c.MaxRequestsPerSecond)
+	transport := c.transport(limiter)
+
+	pingdomClient, err := c.pingdomClient(&http.Client{Transport: transport})
 	if err != nil {
 		return nil, err
 	}
-	if user := os.Getenv("SOLARWINDS_USER"); user != "" {
-		if password := os.Getenv("SOLARWINDS_PASSWD"); password != "" {
-			c.SolarwindsUser = user
-			c.SolarwindsPassword = password
-		} else {
-			return nil, errors.New("user and password must be present together")
-		}
+
+	clients := &Clients{
+		Pingdom:   pingdomClient,
+		config:    c,
+		transport: transport,
+		limiter:   limiter,
 	}
-	if orgID := os.Getenv("SOLARWINDS_ORG_ID"); orgID != "" {
-		c.SolarwindsOrgID = orgID
+	clients.initCaches()
+	if c.EnforceCreditsForCreations {
+		clients.quota = newCreditQuota(func() (*credits, error) {
+			return readCredits(pingdomClient)
+		})
 	}
-	solarwindsClient, err := solarwinds.NewClient(solarwinds.ClientConfig{
-		Username: c.SolarwindsUser,
-		Password: c.SolarwindsPassword,
+
+	return clients, nil
+}
+
+// Solarwinds returns the SolarWinds client, logging in on first use. The
+// resource needing the client is named in the error when the credentials
+// are missing.
+func (c *Clients) Solarwinds(resource string) (*solarwinds.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.solarwinds != nil {
+		return c.solarwinds, nil
+	}
+	if err := c.config.requireSolarwindsCredentials(resource); err != nil {
+		return nil, err
+	}
+
+	// The SolarWinds client has no option for its HTTP client, so its
+	// requests are neither retried nor rate limited.
+	client, err := solarwinds.NewClient(solarwinds.ClientConfig{
+		Username: c.config.SolarwindsUser,
+		Password: c.config.SolarwindsPassword,
+		BaseURL:  strings.TrimSuffix(c.config.SolarwindsBaseURL, "/"),
 	})
 	if err != nil {
 		return nil, err
 	}
-	err = solarwindsClient.Init()
-	if err != nil {
+	if err := client.Init(); err != nil {
+		return nil, fmt.Errorf("Error logging in to SolarWinds: %s", err)
+	}
+
+	log.Printf("[INFO] SolarWinds Client configured.")
+	c.solarwinds = client
+	return client, nil
+}
+
+// PingdomExt returns the client of the Pingdom web API, logging in on first
+// use. The resource needing the client is named in the error when the
+// credentials are missing.
+func (c *Clients) PingdomExt(resource string) (*pingdomext.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.pingdomExt != nil {
+		return c.pingdomExt, nil
+	}
+	if err := c.config.requireSolarwindsCredentials(resource); err != nil {
 		return nil, err
 	}
 
-	pingdomClientExt, err := pingdomext.NewClientWithConfig(pingdomext.ClientConfig{
-		Username: c.SolarwindsUser,
-		Password: c.SolarwindsPassword,
-		OrgID:    c.SolarwindsOrgID,
+	client, err := pingdomext.NewClientWithConfig(pingdomext.ClientConfig{
+		Username: c.config.SolarwindsUser,
+		Password: c.config.SolarwindsPassword,
+		OrgID:    c.config.SolarwindsOrgID,
+		AuthURL:  c.config.solarwindsAuthURL(),
+		BaseURL:  strings.TrimSuffix(c.config.PingdomExtBaseURL, "/"),
+		HTTPClient: &http.Client{
+			Transport: c.transport,
+			// The login flow reads the redirects instead of following them.
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
 	})
-
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("Error logging in to the Pingdom web API: %s", err)
 	}
 
-	return &Clients{
-		Pingdom:    pingdomClient,
-		PingdomExt: pingdomClientExt,
-		Solarwinds: solarwindsClient,
-	}, nil
+	log.Printf("[INFO] Pingdom web API Client configured.")
+	c.pingdomExt = client
+	return client, nil
+}
+
+func (c *Clients) initCaches() {
+	c.checks = newIDCache(func() ([]int, error) {
+		checks, err := c.Pingdom.Checks.List()
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, 0, len(checks))
+		for _, check := range checks {
+			ids = append(ids, check.ID)
+		}
+		return ids, nil
+	})
+	c.tmsChecks = newIDCache(func() ([]int, error) {
+		checks, err := c.Pingdom.TMSCheck.List()
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, 0, len(checks))
+		for _, check := range checks {
+			ids = append(ids, check.ID)
+		}
+		return ids, nil
+	})
+	c.teams = newIDCache(func() ([]int, error) {
+		teams, err := c.Pingdom.Teams.List()
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, 0, len(teams))
+		for _, team := range teams {
+			ids = append(ids, team.ID)
+		}
+		return ids, nil
+	})
+	c.integrations = newIDCache(func() ([]int, error) {
+		client, err := c.PingdomExt("pingdom_integration")
+		if err != nil {
+			return nil, err
+		}
+		integrations, err := client.Integrations.List()
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, 0, len(integrations))
+		for _, integration := range integrations {
+			ids = append(ids, integration.ID)
+		}
+		return ids, nil
+	})
 }
 
 // Client returns a new client for accessing pingdom.
 //
-func (c *Config) pingdomClient() (*pingdom.Client, error) {
-	if v := os.Getenv("PINGDOM_API_TOKEN"); v != "" {
-		c.APIToken = v
+func (c *Config) pingdomClient(httpClient *http.Client) (*pingdom.Client, error) {
+	client, err := pingdom.NewClientWithConfig(pingdom.ClientConfig{
+		APIToken:   c.APIToken,
+		BaseURL:    strings.TrimSuffix(c.PingdomBaseURL, "/"),
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	client, _ := pingdom.NewClientWithConfig(pingdom.ClientConfig{APIToken: c.APIToken})
-
 	log.Printf("[INFO] Pingdom Client configured.")
 
 	return client, nil
 }
+
+// transport returns the HTTP transport shared by all the clients. Every retry
+// waits for its own slot of the limiter.
+func (c *Config) transport(limiter *rateLimiter) http.RoundTripper {
+	base := &rateLimitTransport{base: http.DefaultTransport, limiter: limiter}
+	return newRetryTransport(base, c.MaxRetries, time.Duration(c.MaxRetryWait)*time.Second)
+}
+
+// requireSolarwindsCredentials fails, naming the resource needing them, when
+// the SolarWinds credentials aren't configured.
+func (c *Config) requireSolarwindsCredentials(resource string) error {
+	if c.SolarwindsUser == "" || c.SolarwindsPassword == "" {
+		return fmt.Errorf("%s requires SolarWinds credentials, set the solarwinds_user and solarwinds_password provider arguments, the SOLARWINDS_USER and SOLARWINDS_PASSWORD environment variables or a shared credentials profile", resource)
+	}
+	return nil
+}
+
+// solarwindsAuthURL returns the login URL the pingdomext client authenticates
+// against, which lives on the SolarWinds endpoint. It's empty, for the
+// client's default, when no SolarWinds base URL is configured.
+func (c *Config) solarwindsAuthURL() string {
+	if c.SolarwindsBaseURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(c.SolarwindsBaseURL, "/") + "/v1/login"
+}
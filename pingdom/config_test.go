@@ -0,0 +1,61 @@
+package pingdom
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPingdomClientBaseURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{baseURL: "", want: "https://api.pingdom.com/api/3.1"},
+		{baseURL: "http://localhost:8080/api/3.1", want: "http://localhost:8080/api/3.1"},
+		{baseURL: "http://localhost:8080/api/3.1/", want: "http://localhost:8080/api/3.1"},
+	}
+
+	for _, tt := range tests {
+		config := &Config{APIToken: "token", PingdomBaseURL: tt.baseURL}
+		client, err := config.pingdomClient(nil)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", tt.baseURL, err)
+		}
+		if got := client.BaseURL.String(); got != tt.want {
+			t.Errorf("%q: BaseURL = %q, want %q", tt.baseURL, got, tt.want)
+		}
+	}
+}
+
+func TestSolarwindsAuthURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{baseURL: "", want: ""},
+		{baseURL: "http://localhost:8081", want: "http://localhost:8081/v1/login"},
+		{baseURL: "http://localhost:8081/", want: "http://localhost:8081/v1/login"},
+	}
+
+	for _, tt := range tests {
+		config := &Config{SolarwindsBaseURL: tt.baseURL}
+		if got := config.solarwindsAuthURL(); got != tt.want {
+			t.Errorf("%q: solarwindsAuthURL() = %q, want %q", tt.baseURL, got, tt.want)
+		}
+	}
+}
+
+func TestClientsMissingSolarwindsCredentials(t *testing.T) {
+	config := &Config{APIToken: "token"}
+	clients, err := config.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := clients.Solarwinds("pingdom_user"); err == nil || !strings.Contains(err.Error(), "pingdom_user requires SolarWinds credentials") {
+		t.Errorf("Solarwinds() error = %v, want one naming pingdom_user", err)
+	}
+	if _, err := clients.PingdomExt("pingdom_integration"); err == nil || !strings.Contains(err.Error(), "pingdom_integration requires SolarWinds credentials") {
+		t.Errorf("PingdomExt() error = %v, want one naming pingdom_integration", err)
+	}
+}
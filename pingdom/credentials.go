@@ -0,0 +1,179 @@
+package pingdom
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const defaultProfile = "default"
+
+// credential is one credential of the chain, with where each source keeps
+// it.
+type credential struct {
+	value *string
+	env   string
+	// deprecatedEnv is the former name of env, still read with a warning.
+	deprecatedEnv string
+	// file is the path given by the *_file argument, if there is one.
+	file string
+	// profileKey is the key of the credential in a shared credentials profile.
+	profileKey string
+}
+
+// resolveCredentials fills in the credentials that aren't set as provider
+// arguments, looking in order at the environment variables, the *_file
+// arguments and the profile of the shared credentials file. Deprecated names
+// are accepted with a warning.
+func (c *Config) resolveCredentials() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c.SolarwindsPassword == "" {
+		c.SolarwindsPassword = c.SolarwindsPasswd
+	}
+
+	credentials := []credential{
+		{value: &c.APIToken, env: "PINGDOM_API_TOKEN", file: c.APITokenFile, profileKey: "api_token"},
+		{value: &c.SolarwindsUser, env: "SOLARWINDS_USER", profileKey: "solarwinds_user"},
+		{value: &c.SolarwindsPassword, env: "SOLARWINDS_PASSWORD", deprecatedEnv: "SOLARWINDS_PASSWD", file: c.SolarwindsPasswordFile, profileKey: "solarwinds_password"},
+		{value: &c.SolarwindsOrgID, env: "SOLARWINDS_ORG_ID", profileKey: "solarwinds_org_id"},
+	}
+
+	missing := false
+	for _, cred := range credentials {
+		if *cred.value != "" {
+			continue
+		}
+		*cred.value = os.Getenv(cred.env)
+		if *cred.value == "" && cred.deprecatedEnv != "" {
+			if *cred.value = os.Getenv(cred.deprecatedEnv); *cred.value != "" {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("The %s environment variable is deprecated", cred.deprecatedEnv),
+					Detail:   fmt.Sprintf("Use %s instead.", cred.env),
+				})
+			}
+		}
+		if *cred.value == "" && cred.file != "" {
+			v, err := readCredentialFile(cred.file)
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+			*cred.value = v
+		}
+		if *cred.value == "" {
+			missing = true
+		}
+	}
+
+	if missing {
+		profile, err := c.sharedCredentialsProfile()
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		for _, cred := range credentials {
+			if *cred.value == "" {
+				*cred.value = profile[cred.profileKey]
+			}
+		}
+	}
+
+	if (c.SolarwindsUser == "") != (c.SolarwindsPassword == "") {
+		return append(diags, diag.Errorf("solarwinds_user and solarwinds_password must be present together")...)
+	}
+	return diags
+}
+
+// readCredentialFile reads a credential from a file, like a mounted secret,
+// ignoring the surrounding whitespace.
+func readCredentialFile(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Error reading credentials file: %s", err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// sharedCredentialsProfile returns the credentials of the selected profile of
+// the shared credentials file. Without an explicit file or profile, a missing
+// default file or profile is no error.
+func (c *Config) sharedCredentialsProfile() (map[string]string, error) {
+	path := c.SharedCredentialsFile
+	explicit := path != "" || c.Profile != ""
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			if explicit {
+				return nil, err
+			}
+			return nil, nil
+		}
+		path = filepath.Join(home, ".pingdom", "credentials")
+	}
+	name := c.Profile
+	if name == "" {
+		name = defaultProfile
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) && !explicit {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error reading shared credentials file: %s", err)
+	}
+	defer f.Close()
+
+	profiles, err := parseSharedCredentials(f)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading shared credentials file %s: %s", path, err)
+	}
+	profile, ok := profiles[name]
+	if !ok && explicit {
+		return nil, fmt.Errorf("profile %q not found in shared credentials file %s", name, path)
+	}
+	return profile, nil
+}
+
+// parseSharedCredentials parses a shared credentials file, an INI file with a
+// section per profile:
+//
+//	[default]
+//	api_token = ...
+//	solarwinds_user = ...
+//	solarwinds_password = ...
+func parseSharedCredentials(r io.Reader) (map[string]map[string]string, error) {
+	profiles := map[string]map[string]string{}
+	var profile map[string]string
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = map[string]string{}
+			}
+			profile = profiles[name]
+		default:
+			i := strings.Index(line, "=")
+			if i < 0 {
+				return nil, fmt.Errorf("line %d: expected key = value", n)
+			}
+			if profile == nil {
+				return nil, fmt.Errorf("line %d: key outside of a profile", n)
+			}
+			profile[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+		}
+	}
+	return profiles, scanner.Err()
+}
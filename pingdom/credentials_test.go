@@ -0,0 +1,232 @@
+package pingdom
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// setCredentialsEnv sets the credential environment variables for a test,
+// unsetting the ones not given, and restores them afterwards.
+func setCredentialsEnv(t *testing.T, env map[string]string) {
+	names := []string{"PINGDOM_API_TOKEN", "SOLARWINDS_USER", "SOLARWINDS_PASSWORD", "SOLARWINDS_PASSWD", "SOLARWINDS_ORG_ID", "HOME"}
+	for _, name := range names {
+		old, ok := os.LookupEnv(name)
+		t.Cleanup(func() {
+			if ok {
+				os.Setenv(name, old)
+			} else {
+				os.Unsetenv(name)
+			}
+		})
+		if v, set := env[name]; set {
+			os.Setenv(name, v)
+		} else {
+			os.Unsetenv(name)
+		}
+	}
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestResolveCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pingdom-credentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tokenFile := writeTestFile(t, dir, "token", "file-token\n")
+	passwordFile := writeTestFile(t, dir, "password", "file-password\n")
+	writeTestFile(t, dir, ".pingdom/credentials", `
+[default]
+api_token = default-token
+solarwinds_user = default-user
+solarwinds_password = default-password
+solarwinds_org_id = default-org
+
+[staging]
+api_token = staging-token
+solarwinds_user = staging-user
+solarwinds_password = staging-password
+`)
+
+	tests := []struct {
+		name         string
+		config       Config
+		env          map[string]string
+		want         Config
+		wantWarnings int
+	}{
+		{
+			name:   "arguments",
+			config: Config{APIToken: "arg-token", SolarwindsUser: "arg-user", SolarwindsPassword: "arg-password", SolarwindsOrgID: "arg-org"},
+			env:    map[string]string{"PINGDOM_API_TOKEN": "env-token", "SOLARWINDS_USER": "env-user"},
+			want:   Config{APIToken: "arg-token", SolarwindsUser: "arg-user", SolarwindsPassword: "arg-password", SolarwindsOrgID: "arg-org"},
+		},
+		{
+			name:   "environment",
+			config: Config{APITokenFile: tokenFile, SolarwindsPasswordFile: passwordFile},
+			env:    map[string]string{"PINGDOM_API_TOKEN": "env-token", "SOLARWINDS_USER": "env-user", "SOLARWINDS_PASSWORD": "env-password"},
+			want:   Config{APIToken: "env-token", SolarwindsUser: "env-user", SolarwindsPassword: "env-password", SolarwindsOrgID: "default-org"},
+		},
+		{
+			name:   "files",
+			config: Config{APITokenFile: tokenFile, SolarwindsPasswordFile: passwordFile},
+			want:   Config{APIToken: "file-token", SolarwindsUser: "default-user", SolarwindsPassword: "file-password", SolarwindsOrgID: "default-org"},
+		},
+		{
+			name:   "default profile",
+			config: Config{},
+			want:   Config{APIToken: "default-token", SolarwindsUser: "default-user", SolarwindsPassword: "default-password", SolarwindsOrgID: "default-org"},
+		},
+		{
+			name:   "named profile",
+			config: Config{Profile: "staging"},
+			want:   Config{APIToken: "staging-token", SolarwindsUser: "staging-user", SolarwindsPassword: "staging-password"},
+		},
+		{
+			name:   "deprecated argument",
+			config: Config{APIToken: "arg-token", SolarwindsUser: "arg-user", SolarwindsPasswd: "arg-passwd", SolarwindsOrgID: "arg-org"},
+			want:   Config{APIToken: "arg-token", SolarwindsUser: "arg-user", SolarwindsPassword: "arg-passwd", SolarwindsOrgID: "arg-org"},
+		},
+		{
+			name:         "deprecated environment variable",
+			config:       Config{APIToken: "arg-token", SolarwindsUser: "arg-user", SolarwindsOrgID: "arg-org"},
+			env:          map[string]string{"SOLARWINDS_PASSWD": "env-passwd"},
+			want:         Config{APIToken: "arg-token", SolarwindsUser: "arg-user", SolarwindsPassword: "env-passwd", SolarwindsOrgID: "arg-org"},
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := map[string]string{"HOME": dir}
+			for k, v := range tt.env {
+				env[k] = v
+			}
+			setCredentialsEnv(t, env)
+
+			config := tt.config
+			diags := config.resolveCredentials()
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(diags) != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d", len(diags), tt.wantWarnings)
+			}
+
+			got := Config{
+				APIToken:           config.APIToken,
+				SolarwindsUser:     config.SolarwindsUser,
+				SolarwindsPassword: config.SolarwindsPassword,
+				SolarwindsOrgID:    config.SolarwindsOrgID,
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveCredentialsErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pingdom-credentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	setCredentialsEnv(t, map[string]string{"HOME": dir})
+
+	credentialsFile := writeTestFile(t, dir, "credentials", "[default]\napi_token = token\n")
+
+	tests := []struct {
+		name   string
+		config Config
+		want   string
+	}{
+		{
+			name:   "missing profile",
+			config: Config{SharedCredentialsFile: credentialsFile, Profile: "production"},
+			want:   `profile "production" not found`,
+		},
+		{
+			name:   "missing credentials file",
+			config: Config{SharedCredentialsFile: filepath.Join(dir, "missing")},
+			want:   "Error reading shared credentials file",
+		},
+		{
+			name:   "missing token file",
+			config: Config{APITokenFile: filepath.Join(dir, "missing")},
+			want:   "Error reading credentials file",
+		},
+		{
+			name:   "user without password",
+			config: Config{APIToken: "token", SolarwindsUser: "user", SolarwindsOrgID: "org"},
+			want:   "must be present together",
+		},
+	}
+
+	for _, tt := range tests {
+		config := tt.config
+		diags := config.resolveCredentials()
+		if !diags.HasError() || !strings.Contains(diags[len(diags)-1].Summary, tt.want) {
+			t.Errorf("%s: got %v, want an error containing %q", tt.name, diags, tt.want)
+		}
+	}
+}
+
+func TestResolveCredentialsWithoutDefaultFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pingdom-credentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	setCredentialsEnv(t, map[string]string{"HOME": dir})
+
+	config := Config{}
+	if diags := config.resolveCredentials(); diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+}
+
+func TestParseSharedCredentials(t *testing.T) {
+	profiles, err := parseSharedCredentials(strings.NewReader(`
+# Pingdom accounts
+[default]
+api_token = abc=123
+
+; staging account
+[ staging ]
+api_token=def
+solarwinds_user = ops@example.com
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]map[string]string{
+		"default": {"api_token": "abc=123"},
+		"staging": {"api_token": "def", "solarwinds_user": "ops@example.com"},
+	}
+	if !reflect.DeepEqual(profiles, want) {
+		t.Errorf("parseSharedCredentials() = %v, want %v", profiles, want)
+	}
+
+	for _, content := range []string{"api_token = abc", "[default]\napi_token"} {
+		if _, err := parseSharedCredentials(strings.NewReader(content)); err == nil {
+			t.Errorf("parseSharedCredentials(%q): expected an error", content)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+// credits are the account credits.
+type credits struct {
+	CheckLimit           int  `json:"checklimit"`
+	AvailableChecks      int  `json:"availablechecks"`
+	UsedDefault          int  `json:"useddefault"`
+	AvailableDefault     int  `json:"availabledefault"`
+	UsedTransaction      int  `json:"usedtransaction"`
+	AvailableTransaction int  `json:"availabletransaction"`
+	AvailableSMS         int  `json:"availablesms"`
+	AvailableSMSTests    int  `json:"availablesmstests"`
+	AutoFillSMS          bool `json:"autofillsms"`
+}
+
+type creditsJSONResponse struct {
+	Credits credits `json:"credits"`
+}
+
+func readCredits(client *pingdom.Client) (*credits, error) {
+	m := &creditsJSONResponse{}
+	if err := getPingdomJSON(client, "/credits", nil, m); err != nil {
+		return nil, err
+	}
+	return &m.Credits, nil
+}
+
+// creditQuota counts the checks and TMS checks planned for creation against
+// the available credits, which are read once. It's a gross count: planned
+// deletions don't give credits back, as they aren't diffed by the provider.
+type creditQuota struct {
+	read func() (*credits, error)
+
+	mu        sync.Mutex
+	credits   *credits
+	checks    int
+	tmsChecks int
+}
+
+func newCreditQuota(read func() (*credits, error)) *creditQuota {
+	return &creditQuota{read: read}
+}
+
+// ReserveCheck counts one more uptime check, failing when the credits don't
+// cover it.
+func (q *creditQuota) ReserveCheck() error {
+	return q.reserve("uptime checks", &q.checks, func(c *credits) int { return c.AvailableDefault })
+}
+
+// ReserveTmsCheck counts one more TMS check, failing when the credits don't
+// cover it.
+func (q *creditQuota) ReserveTmsCheck() error {
+	return q.reserve("TMS checks", &q.tmsChecks, func(c *credits) int { return c.AvailableTransaction })
+}
+
+func (q *creditQuota) reserve(kind string, reserved *int, available func(*credits) int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.credits == nil {
+		c, err := q.read()
+		if err != nil {
+			return fmt.Errorf("Error retrieving credits: %s", err)
+		}
+		q.credits = c
+	}
+
+	if *reserved >= available(q.credits) {
+		return fmt.Errorf("the Pingdom account has credits for %d more %s, this plan creates more", available(q.credits), kind)
+	}
+	*reserved++
+	return nil
+}
+
+// customizeDiffReserveCheckCredit reserves a credit for a new check when the
+// provider enforces credits for creations.
+func customizeDiffReserveCheckCredit(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if clients, ok := meta.(*Clients); ok && clients.quota != nil && d.Id() == "" {
+		return clients.quota.ReserveCheck()
+	}
+	return nil
+}
+
+// customizeDiffReserveTmsCheckCredit reserves a credit for a new TMS check
+// when the provider enforces credits for creations.
+func customizeDiffReserveTmsCheckCredit(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if clients, ok := meta.(*Clients); ok && clients.quota != nil && d.Id() == "" {
+		return clients.quota.ReserveTmsCheck()
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package pingdom
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func TestReadCredits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/credits" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"credits": {
+			"checklimit": 100,
+			"availablechecks": 40,
+			"useddefault": 50,
+			"availabledefault": 30,
+			"usedtransaction": 10,
+			"availabletransaction": 10,
+			"availablesms": 200,
+			"availablesmstests": 20,
+			"autofillsms": true
+		}}`)
+	}))
+	defer server.Close()
+
+	client, err := pingdom.NewClientWithConfig(pingdom.ClientConfig{APIToken: "token", BaseURL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := readCredits(client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := credits{
+		CheckLimit:           100,
+		AvailableChecks:      40,
+		UsedDefault:          50,
+		AvailableDefault:     30,
+		UsedTransaction:      10,
+		AvailableTransaction: 10,
+		AvailableSMS:         200,
+		AvailableSMSTests:    20,
+		AutoFillSMS:          true,
+	}
+	if *c != want {
+		t.Errorf("readCredits() = %+v, want %+v", *c, want)
+	}
+}
+
+func TestCreditQuota(t *testing.T) {
+	reads := 0
+	quota := newCreditQuota(func() (*credits, error) {
+		reads++
+		return &credits{AvailableDefault: 2, AvailableTransaction: 1}, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		if err := quota.ReserveCheck(); err != nil {
+			t.Fatalf("check %d: unexpected error: %s", i, err)
+		}
+	}
+	if err := quota.ReserveCheck(); err == nil {
+		t.Error("expected an error when the checks exceed the credits")
+	}
+
+	if err := quota.ReserveTmsCheck(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := quota.ReserveTmsCheck(); err == nil {
+		t.Error("expected an error when the TMS checks exceed the credits")
+	}
+
+	if reads != 1 {
+		t.Errorf("credits read %d times, want 1", reads)
+	}
+}
+
+func TestCreditQuotaReadError(t *testing.T) {
+	quota := newCreditQuota(func() (*credits, error) {
+		return nil, errors.New("unauthorized")
+	})
+
+	if err := quota.ReserveCheck(); err == nil {
+		t.Error("expected the read error")
+	}
+}
@@ -0,0 +1,102 @@
+package pingdom
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePingdomCheck() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(resourcePingdomCheckSchema())
+	s["id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name", "host"},
+	}
+	s["name"].Optional = true
+	s["name"].AtLeastOneOf = []string{"id", "name"}
+	s["host"].Optional = true
+	// Without state to tell which headers the owner of the check keeps
+	// sensitive, any of them may hold a secret.
+	s["requestheaders"].Sensitive = true
+
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomCheckRead,
+		Schema:      s,
+	}
+}
+
+// dataSourceSchemaFromResourceSchema turns a resource schema into the schema
+// of a data source exposing the same attributes, all computed.
+func dataSourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		dv := &schema.Schema{
+			Type:        v.Type,
+			Description: v.Description,
+			Sensitive:   v.Sensitive,
+			Computed:    true,
+		}
+
+		switch elem := v.Elem.(type) {
+		case *schema.Resource:
+			dv.Elem = &schema.Resource{Schema: dataSourceSchemaFromResourceSchema(elem.Schema)}
+		case *schema.Schema:
+			dv.Elem = &schema.Schema{Type: elem.Type}
+		}
+
+		ds[k] = dv
+	}
+	return ds
+}
+
+func dataSourcePingdomCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	var id int
+	if v, ok := d.GetOk("id"); ok {
+		var err error
+		if id, err = strconv.Atoi(v.(string)); err != nil {
+			return diag.Errorf("Invalid check id '%s': %s", v, err)
+		}
+	} else {
+		name := d.Get("name").(string)
+		host := d.Get("host").(string)
+
+		checks, err := client.Checks.List()
+		if err != nil {
+			return diag.Errorf("Error retrieving list of checks: %s", err)
+		}
+
+		var matches []int
+		for _, check := range checks {
+			if check.Name == name && (host == "" || check.Hostname == host) {
+				matches = append(matches, check.ID)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return diag.Errorf("Check '%s' not found", name)
+		case 1:
+			id = matches[0]
+		default:
+			return diag.Errorf("%d checks named '%s' found (IDs %s), narrow the search with `host` or use `id`", len(matches), name, intListToCDString(matches))
+		}
+	}
+
+	ck, details, err := readCheck(client, id)
+	if err != nil {
+		return diag.Errorf("Error retrieving check: %s", err)
+	}
+
+	if err := updateResourceFromCheckResponse(d, ck, details); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(ck.ID))
+	return nil
+}
@@ -0,0 +1,204 @@
+package pingdom
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+// checkResultsPageSize is the largest number of results the results API
+// returns per request.
+const checkResultsPageSize = 1000
+
+func dataSourcePingdomCheckResults() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomCheckResultsRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"probes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"status": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"up", "down", "unconfirmed", "unknown"}, false),
+				},
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      checkResultsPageSize,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"probe_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"response_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status_desc": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_desc_long": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// checkResult is a raw check result.
+type checkResult struct {
+	ProbeID        int    `json:"probeid"`
+	Time           int64  `json:"time"`
+	Status         string `json:"status"`
+	ResponseTime   int    `json:"responsetime"`
+	StatusDesc     string `json:"statusdesc"`
+	StatusDescLong string `json:"statusdesclong"`
+}
+
+type checkResultsJSONResponse struct {
+	Results []checkResult `json:"results"`
+}
+
+// readCheckResults returns up to limit results of a check, most recent first,
+// requesting as many pages as needed.
+func readCheckResults(client *pingdom.Client, id int, params map[string]string, limit int) ([]checkResult, error) {
+	// Pages are requested by offset, so results arriving between two pages
+	// would shift them. Without a configured end, the window ends when the
+	// first page is requested.
+	to, ok := params["to"]
+	if !ok {
+		to = strconv.FormatInt(time.Now().Unix(), 10)
+	}
+
+	results := []checkResult{}
+	for len(results) < limit {
+		pageSize := limit - len(results)
+		if pageSize > checkResultsPageSize {
+			pageSize = checkResultsPageSize
+		}
+
+		page := map[string]string{
+			"limit":  strconv.Itoa(pageSize),
+			"offset": strconv.Itoa(len(results)),
+		}
+		for k, v := range params {
+			page[k] = v
+		}
+		page["to"] = to
+
+		m := &checkResultsJSONResponse{}
+		if err := getPingdomJSON(client, "/results/"+strconv.Itoa(id), page, m); err != nil {
+			return nil, err
+		}
+
+		results = append(results, m.Results...)
+		if len(m.Results) < pageSize {
+			break
+		}
+	}
+	return results, nil
+}
+
+func dataSourcePingdomCheckResultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	id := d.Get("check_id").(int)
+	limit := d.Get("limit").(int)
+
+	params := map[string]string{}
+	if v, ok, err := getTime("from", d); err != nil {
+		return diag.FromErr(err)
+	} else if ok {
+		params["from"] = strconv.FormatInt(v, 10)
+	}
+	if v, ok, err := getTime("to", d); err != nil {
+		return diag.FromErr(err)
+	} else if ok {
+		params["to"] = strconv.FormatInt(v, 10)
+	}
+	if v, ok := d.GetOk("probes"); ok {
+		probes := make([]int, 0, v.(*schema.Set).Len())
+		for _, probe := range v.(*schema.Set).List() {
+			probes = append(probes, probe.(int))
+		}
+		sort.Ints(probes)
+		params["probes"] = intListToCDString(probes)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		statuses := make([]string, 0, v.(*schema.Set).Len())
+		for _, status := range v.(*schema.Set).List() {
+			statuses = append(statuses, status.(string))
+		}
+		sort.Strings(statuses)
+		params["status"] = strings.Join(statuses, ",")
+	}
+
+	results, err := readCheckResults(client, id, params, limit)
+	if err != nil {
+		return diag.Errorf("Error retrieving check results: %s", err)
+	}
+
+	objects := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		objects = append(objects, map[string]interface{}{
+			"time":             timeFormat(result.Time),
+			"probe_id":         result.ProbeID,
+			"status":           result.Status,
+			"response_time":    result.ResponseTime,
+			"status_desc":      result.StatusDesc,
+			"status_desc_long": result.StatusDescLong,
+		})
+	}
+	if err := d.Set("results", objects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(Strings([]string{strconv.Itoa(id), params["from"], params["to"], params["probes"], params["status"], strconv.Itoa(limit)}))
+	return nil
+}
@@ -0,0 +1,116 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func TestAccDataSourcePingdomCheckResults_basic(t *testing.T) {
+	datasourceName := "data.pingdom_check_results.test"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomCheckResultsConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePingdomCheckResultsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "test" {
+	name       = "%s"
+	host       = "www.example.com"
+	type       = "http"
+	resolution = 1
+}
+
+data "pingdom_check_results" "test" {
+	check_id = pingdom_check.test.id
+	status   = ["up", "down"]
+	limit    = 10
+}
+`, name)
+}
+
+func TestReadCheckResultsPaging(t *testing.T) {
+	const available = 2500
+
+	var requests int
+	var to string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		if q.Get("to") == "" {
+			t.Error("got no window end")
+		}
+		if requests > 1 && q.Get("to") != to {
+			t.Errorf("page %d ends at %s, the first one at %s", requests, q.Get("to"), to)
+		}
+		to = q.Get("to")
+		if q.Get("status") != "down" {
+			t.Errorf("got status %q, want down", q.Get("status"))
+		}
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		if limit > checkResultsPageSize {
+			t.Errorf("requested %d results, more than a page", limit)
+		}
+
+		m := checkResultsJSONResponse{Results: []checkResult{}}
+		for i := offset; i < offset+limit && i < available; i++ {
+			m.Results = append(m.Results, checkResult{Time: int64(available - i), Status: "down"})
+		}
+		if err := json.NewEncoder(w).Encode(m); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	client, err := pingdom.NewClientWithConfig(pingdom.ClientConfig{APIToken: "token", BaseURL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		limit    int
+		results  int
+		requests int
+	}{
+		{10, 10, 1},
+		{1000, 1000, 1},
+		{1500, 1500, 2},
+		{5000, available, 3},
+	}
+
+	for _, c := range cases {
+		requests = 0
+		results, err := readCheckResults(client, 42, map[string]string{"status": "down"}, c.limit)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(results) != c.results || requests != c.requests {
+			t.Errorf("limit %d: got %d results in %d requests, want %d in %d", c.limit, len(results), requests, c.results, c.requests)
+		}
+		for i, result := range results {
+			if result.Time != int64(available-i) {
+				t.Fatalf("limit %d: result %d out of order", c.limit, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomCheckSummary() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomCheckSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"uptime_percentage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_up": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_down": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_unknown": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"average_response_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"outages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type summaryAverageJSONResponse struct {
+	Summary struct {
+		ResponseTime struct {
+			AvgResponse int `json:"avgresponse"`
+		} `json:"responsetime"`
+		Status struct {
+			TotalUp      int `json:"totalup"`
+			TotalDown    int `json:"totaldown"`
+			TotalUnknown int `json:"totalunknown"`
+		} `json:"status"`
+	} `json:"summary"`
+}
+
+type summaryOutageState struct {
+	Status   string `json:"status"`
+	TimeFrom int64  `json:"timefrom"`
+	TimeTo   int64  `json:"timeto"`
+}
+
+type summaryOutageJSONResponse struct {
+	Summary struct {
+		States []summaryOutageState `json:"states"`
+	} `json:"summary"`
+}
+
+// checkSummary is the uptime summary of a check over a time range.
+type checkSummary struct {
+	TotalUp             int
+	TotalDown           int
+	TotalUnknown        int
+	AverageResponseTime int
+	Outages             []summaryOutageState
+}
+
+// UptimePercentage returns the share of the time the check was up, ignoring
+// the time its state was unknown, as Pingdom reports uptime.
+func (s *checkSummary) UptimePercentage() float64 {
+	known := s.TotalUp + s.TotalDown
+	if known == 0 {
+		return 0
+	}
+	return float64(s.TotalUp) * 100 / float64(known)
+}
+
+// readCheckSummary returns the uptime summary of a check between from and to,
+// given as Unix timestamps.
+func readCheckSummary(client *pingdom.Client, id int, from, to int64) (*checkSummary, error) {
+	params := map[string]string{
+		"from":          strconv.FormatInt(from, 10),
+		"to":            strconv.FormatInt(to, 10),
+		"includeuptime": "true",
+	}
+
+	average := &summaryAverageJSONResponse{}
+	if err := getPingdomJSON(client, "/summary.average/"+strconv.Itoa(id), params, average); err != nil {
+		return nil, err
+	}
+	outage := &summaryOutageJSONResponse{}
+	if err := getPingdomJSON(client, "/summary.outage/"+strconv.Itoa(id), params, outage); err != nil {
+		return nil, err
+	}
+
+	summary := &checkSummary{
+		TotalUp:             average.Summary.Status.TotalUp,
+		TotalDown:           average.Summary.Status.TotalDown,
+		TotalUnknown:        average.Summary.Status.TotalUnknown,
+		AverageResponseTime: average.Summary.ResponseTime.AvgResponse,
+		Outages:             []summaryOutageState{},
+	}
+	for _, state := range outage.Summary.States {
+		if state.Status == "down" {
+			summary.Outages = append(summary.Outages, state)
+		}
+	}
+	return summary, nil
+}
+
+func dataSourcePingdomCheckSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	id := d.Get("check_id").(int)
+	from, _, err := getTime("from", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	to, _, err := getTime("to", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if from >= to {
+		return diag.Errorf("`from` must be before `to`")
+	}
+
+	summary, err := readCheckSummary(client, id, from, to)
+	if err != nil {
+		return diag.Errorf("Error retrieving check summary: %s", err)
+	}
+
+	outages := make([]map[string]interface{}, 0, len(summary.Outages))
+	for _, outage := range summary.Outages {
+		outages = append(outages, map[string]interface{}{
+			"from":     timeFormat(outage.TimeFrom),
+			"to":       timeFormat(outage.TimeTo),
+			"duration": int(outage.TimeTo - outage.TimeFrom),
+		})
+	}
+
+	for k, v := range map[string]interface{}{
+		"uptime_percentage":     summary.UptimePercentage(),
+		"total_up":              summary.TotalUp,
+		"total_down":            summary.TotalDown,
+		"total_unknown":         summary.TotalUnknown,
+		"average_response_time": summary.AverageResponseTime,
+		"outages":               outages,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d-%d-%d", id, from, to))
+	return nil
+}
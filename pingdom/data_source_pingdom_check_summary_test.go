@@ -0,0 +1,103 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func TestAccDataSourcePingdomCheckSummary_basic(t *testing.T) {
+	datasourceName := "data.pingdom_check_summary.test"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomCheckSummaryConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "uptime_percentage"),
+					resource.TestCheckResourceAttrSet(datasourceName, "total_up"),
+					resource.TestCheckResourceAttrSet(datasourceName, "total_down"),
+					resource.TestCheckResourceAttrSet(datasourceName, "total_unknown"),
+					resource.TestCheckResourceAttrSet(datasourceName, "average_response_time"),
+					resource.TestCheckResourceAttr(datasourceName, "outages.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePingdomCheckSummaryConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "test" {
+	name = "%s"
+	host = "www.example.com"
+	type = "http"
+}
+
+data "pingdom_check_summary" "test" {
+	check_id = pingdom_check.test.id
+	from     = "2021-01-01T00:00:00Z"
+	to       = "2021-02-01T00:00:00Z"
+}
+`, name)
+}
+
+func TestReadCheckSummary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("from"); got != "1000" {
+			t.Errorf("got from %s, want 1000", got)
+		}
+		switch r.URL.Path {
+		case "/summary.average/42":
+			fmt.Fprint(w, `{"summary": {
+				"responsetime": {"from": 1000, "to": 5000, "avgresponse": 250},
+				"status": {"totalup": 3000, "totaldown": 1000, "totalunknown": 500}
+			}}`)
+		case "/summary.outage/42":
+			fmt.Fprint(w, `{"summary": {"states": [
+				{"status": "up", "timefrom": 1000, "timeto": 2000},
+				{"status": "down", "timefrom": 2000, "timeto": 2600},
+				{"status": "unknown", "timefrom": 2600, "timeto": 3100},
+				{"status": "down", "timefrom": 3100, "timeto": 3500},
+				{"status": "up", "timefrom": 3500, "timeto": 5000}
+			]}}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := pingdom.NewClientWithConfig(pingdom.ClientConfig{APIToken: "token", BaseURL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summary, err := readCheckSummary(client, 42, 1000, 5000)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if summary.TotalUp != 3000 || summary.TotalDown != 1000 || summary.TotalUnknown != 500 || summary.AverageResponseTime != 250 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if got := summary.UptimePercentage(); got != 75 {
+		t.Errorf("got uptime %v, want 75", got)
+	}
+
+	want := []summaryOutageState{
+		{Status: "down", TimeFrom: 2000, TimeTo: 2600},
+		{Status: "down", TimeFrom: 3100, TimeTo: 3500},
+	}
+	if !reflect.DeepEqual(summary.Outages, want) {
+		t.Errorf("got outages %+v, want %+v", summary.Outages, want)
+	}
+}
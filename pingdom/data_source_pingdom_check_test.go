@@ -0,0 +1,69 @@
+package pingdom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePingdomCheck_basic(t *testing.T) {
+	resourceName := "pingdom_check.test"
+	byNameDatasourceName := "data.pingdom_check.by_name"
+	byIDDatasourceName := "data.pingdom_check.by_id"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomCheckConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "host", resourceName, "host"),
+					resource.TestCheckResourceAttr(byNameDatasourceName, "type", "http"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "resolution", resourceName, "resolution"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "url", resourceName, "url"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "tags.#", resourceName, "tags.#"),
+					resource.TestCheckResourceAttrPair(byIDDatasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(byIDDatasourceName, "host", resourceName, "host"),
+					resource.TestCheckResourceAttrSet(byIDDatasourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourcePingdomCheckSensitiveHeaders(t *testing.T) {
+	s := dataSourcePingdomCheck().Schema
+	for _, k := range []string{"requestheaders", "sensitive_requestheaders", "password"} {
+		if !s[k].Sensitive {
+			t.Errorf("%s isn't sensitive", k)
+		}
+	}
+}
+
+func testAccDataSourcePingdomCheckConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "test" {
+	name       = "%s"
+	host       = "www.example.com"
+	type       = "http"
+	resolution = 15
+	url        = "/health"
+	tags       = ["datasource"]
+}
+
+data "pingdom_check" "by_name" {
+	name = pingdom_check.test.name
+	host = pingdom_check.test.host
+}
+
+data "pingdom_check" "by_id" {
+	id = pingdom_check.test.id
+}
+`, name)
+}
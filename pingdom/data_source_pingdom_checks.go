@@ -0,0 +1,189 @@
+package pingdom
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+var checkStatuses = []string{"up", "down", "unconfirmed_down", "unknown", "paused"}
+
+func dataSourcePingdomChecks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomChecksRead,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(checkTypes, false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(checkStatuses, false),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"hosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"checks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resolution": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// checkFilter holds the filters the checks API doesn't support, which are
+// applied to the listing.
+type checkFilter struct {
+	Type      string
+	Status    string
+	Host      string
+	NameRegex *regexp.Regexp
+}
+
+func (f *checkFilter) match(ck *pingdom.CheckResponse) bool {
+	if f.Type != "" && ck.Type.Name != f.Type {
+		return false
+	}
+	if f.Status != "" && ck.Status != f.Status {
+		return false
+	}
+	if f.Host != "" && ck.Hostname != f.Host {
+		return false
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(ck.Name) {
+		return false
+	}
+	return true
+}
+
+func dataSourcePingdomChecksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	params := map[string]string{"include_tags": "true"}
+	if v, ok := d.GetOk("tags"); ok {
+		params["tags"] = strings.Join(expandTags(v.(*schema.Set)), ",")
+	}
+
+	filter := &checkFilter{
+		Type:   d.Get("type").(string),
+		Status: d.Get("status").(string),
+		Host:   d.Get("host").(string),
+	}
+	if v, ok := d.GetOk("name_regex"); ok {
+		filter.NameRegex = regexp.MustCompile(v.(string))
+	}
+
+	checks, err := client.Checks.List(params)
+	if err != nil {
+		return diag.Errorf("Error retrieving checks: %s", err)
+	}
+	matches := filterByID(len(checks), func(i int) int { return checks[i].ID }, func(i int) bool { return filter.match(&checks[i]) })
+
+	ids := make([]int, 0, len(matches))
+	names := make([]string, 0, len(matches))
+	hosts := make([]string, 0, len(matches))
+	objects := make([]map[string]interface{}, 0, len(matches))
+	for _, i := range matches {
+		ck := &checks[i]
+		ids = append(ids, ck.ID)
+		names = append(names, ck.Name)
+		hosts = append(hosts, ck.Hostname)
+
+		tags := make([]string, 0, len(ck.Tags))
+		for _, tag := range ck.Tags {
+			tags = append(tags, tag.Name)
+		}
+		objects = append(objects, map[string]interface{}{
+			"id":         ck.ID,
+			"name":       ck.Name,
+			"host":       ck.Hostname,
+			"type":       ck.Type.Name,
+			"status":     ck.Status,
+			"resolution": ck.Resolution,
+			"tags":       tags,
+		})
+	}
+
+	d.SetId(listID(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hosts", hosts); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("checks", objects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package pingdom
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func TestAccDataSourcePingdomChecks_basic(t *testing.T) {
+	datasourceName := "data.pingdom_checks.test"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	tag := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomChecksConfig(name, tag),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ids.0", "pingdom_check.http", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "names.0", name+"-http"),
+					resource.TestCheckResourceAttr(datasourceName, "hosts.0", "www.example.com"),
+					resource.TestCheckResourceAttr(datasourceName, "checks.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "checks.0.type", "http"),
+					resource.TestCheckResourceAttr(datasourceName, "checks.0.tags.0", tag),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePingdomChecksConfig(name, tag string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "http" {
+	name = "%[1]s-http"
+	host = "www.example.com"
+	type = "http"
+	tags = ["%[2]s"]
+}
+
+resource "pingdom_check" "ping" {
+	name = "%[1]s-ping"
+	host = "www.example.com"
+	type = "ping"
+	tags = ["%[2]s"]
+}
+
+data "pingdom_checks" "test" {
+	tags       = [pingdom_check.http.tags[0]]
+	type       = "http"
+	name_regex = "^%[1]s"
+
+	depends_on = [pingdom_check.ping]
+}
+`, name, tag)
+}
+
+func TestCheckFilter(t *testing.T) {
+	checks := []pingdom.CheckResponse{
+		{ID: 1, Name: "web-us", Hostname: "us.example.com", Status: "down", Type: pingdom.CheckResponseType{Name: "http"}},
+		{ID: 2, Name: "db", Hostname: "eu.example.com", Status: "up", Type: pingdom.CheckResponseType{Name: "tcp"}},
+		{ID: 3, Name: "web-eu", Hostname: "eu.example.com", Status: "up", Type: pingdom.CheckResponseType{Name: "http"}},
+	}
+
+	cases := []struct {
+		filter checkFilter
+		ids    []int
+	}{
+		{checkFilter{}, []int{1, 2, 3}},
+		{checkFilter{Type: "http"}, []int{1, 3}},
+		{checkFilter{Status: "up"}, []int{2, 3}},
+		{checkFilter{Host: "eu.example.com"}, []int{2, 3}},
+		{checkFilter{NameRegex: regexp.MustCompile("^web-")}, []int{1, 3}},
+		{checkFilter{Type: "http", Status: "up"}, []int{3}},
+		{checkFilter{Type: "dns"}, []int{}},
+	}
+
+	for _, c := range cases {
+		ids := []int{}
+		for i := range checks {
+			if c.filter.match(&checks[i]) {
+				ids = append(ids, checks[i].ID)
+			}
+		}
+		if !reflect.DeepEqual(ids, c.ids) {
+			t.Errorf("filter %+v: got %v, want %v", c.filter, ids, c.ids)
+		}
+	}
+}
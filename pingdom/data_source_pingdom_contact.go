@@ -109,14 +109,7 @@ func dataSourcePingdomContactRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Error setting name: %s", err)
 	}
 
-	teams := []map[string]interface{}{}
-	for _, team := range found.Teams {
-		teams = append(teams, map[string]interface{}{
-			"id":   team.ID,
-			"name": team.Name,
-		})
-	}
-	if err = d.Set("teams", teams); err != nil {
+	if err = d.Set("teams", flattenContactTeams(found.Teams)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -127,3 +120,14 @@ func dataSourcePingdomContactRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(fmt.Sprintf("%d", found.ID))
 	return nil
 }
+
+func flattenContactTeams(l []pingdom.ContactTeam) []map[string]interface{} {
+	teams := []map[string]interface{}{}
+	for _, team := range l {
+		teams = append(teams, map[string]interface{}{
+			"id":   team.ID,
+			"name": team.Name,
+		})
+	}
+	return teams
+}
@@ -2,11 +2,12 @@ package pingdom
 
 import (
 	"context"
-	"strconv"
-	"time"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nordcloud/go-pingdom/pingdom"
 )
 
 func dataSourcePingdomContacts() *schema.Resource {
@@ -14,6 +15,19 @@ func dataSourcePingdomContacts() *schema.Resource {
 		ReadContext: dataSourcePingdomContactsRead,
 
 		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
 			"names": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -29,10 +43,116 @@ func dataSourcePingdomContacts() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"contacts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"paused": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"teams": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"sms_notification": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"number": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"country_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"severity": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"provider": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"email_notification": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"severity": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// contactFilter holds the contact filters; a nil Paused matches paused and
+// active contacts.
+type contactFilter struct {
+	TeamID    int
+	Paused    *bool
+	NameRegex *regexp.Regexp
+}
+
+func (f *contactFilter) match(c *pingdom.Contact) bool {
+	if f.Paused != nil && c.Paused != *f.Paused {
+		return false
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(c.Name) {
+		return false
+	}
+	if f.TeamID == 0 {
+		return true
+	}
+	for _, team := range c.Teams {
+		if team.ID == f.TeamID {
+			return true
+		}
+	}
+	return false
+}
+
 func dataSourcePingdomContactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Clients).Pingdom
 	contacts, err := client.Contacts.List()
@@ -40,16 +160,35 @@ func dataSourcePingdomContactsRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error retrieving contacts: %s", err)
 	}
 
-	var ids = make([]int, 0, len(contacts))
-	var names = make([]string, 0, len(contacts))
-	var types = make([]string, 0, len(contacts))
-	for _, contact := range contacts {
+	filter := &contactFilter{
+		TeamID: d.Get("team_id").(int),
+		Paused: getOptionalBool(d, "paused"),
+	}
+	if v, ok := d.GetOk("name_regex"); ok {
+		filter.NameRegex = regexp.MustCompile(v.(string))
+	}
+
+	matches := filterByID(len(contacts), func(i int) int { return contacts[i].ID }, func(i int) bool { return filter.match(&contacts[i]) })
+
+	var ids = make([]int, 0, len(matches))
+	var names = make([]string, 0, len(matches))
+	var types = make([]string, 0, len(matches))
+	var objects = make([]map[string]interface{}, 0, len(matches))
+	for _, i := range matches {
+		contact := &contacts[i]
 		ids = append(ids, contact.ID)
 		names = append(names, contact.Name)
 		types = append(types, contact.Type)
+
+		object := flattenContact(contact)
+		object["id"] = contact.ID
+		object["name"] = contact.Name
+		object["type"] = contact.Type
+		object["teams"] = flattenContactTeams(contact.Teams)
+		objects = append(objects, object)
 	}
 
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(listID(ids))
 	if err := d.Set("ids", ids); err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,6 +198,9 @@ func dataSourcePingdomContactsRead(ctx context.Context, d *schema.ResourceData,
 	if err := d.Set("types", types); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("contacts", objects); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -2,10 +2,13 @@ package pingdom
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nordcloud/go-pingdom/pingdom"
 )
 
 func TestAccDataSourcePingdomContacts_basic(t *testing.T) {
@@ -48,3 +51,103 @@ data "pingdom_contacts" "test" {
 }
 `, name)
 }
+
+func TestAccDataSourcePingdomContacts_filtered(t *testing.T) {
+	datasourceName := "data.pingdom_contacts.test"
+	resourceName := "pingdom_contact.test"
+	teamResourceName := "pingdom_team.test"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomContactsConfig_filtered(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "contacts.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttr(datasourceName, "contacts.0.paused", "false"),
+					resource.TestCheckResourceAttrPair(datasourceName, "contacts.0.teams.0.id", teamResourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "contacts.0.sms_notification.0.number", "66666666"),
+					resource.TestCheckResourceAttr(datasourceName, "contacts.0.sms_notification.0.severity", "HIGH"),
+					resource.TestCheckResourceAttr(datasourceName, "contacts.0.email_notification.0.address", "test@test.com"),
+					resource.TestCheckResourceAttr(datasourceName, "contacts.0.email_notification.0.severity", "LOW"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePingdomContactsConfig_filtered(name string) string {
+	return fmt.Sprintf(`
+resource "pingdom_contact" "test" {
+	name = "%[1]s"
+	sms_notification {
+		number   = "66666666"
+		severity = "HIGH"
+	}
+	email_notification {
+		address  = "test@test.com"
+		severity = "LOW"
+	}
+}
+
+resource "pingdom_contact" "other" {
+	name = "%[1]s-other"
+	email_notification {
+		address  = "test@test.com"
+		severity = "LOW"
+	}
+}
+
+resource "pingdom_team" "test" {
+	name = "%[1]s"
+	member_ids = [pingdom_contact.test.id]
+}
+
+data "pingdom_contacts" "test" {
+	team_id    = pingdom_team.test.id
+	paused     = false
+	name_regex = "^%[1]s"
+
+	depends_on = [pingdom_contact.other]
+}
+`, name)
+}
+
+func TestContactFilter(t *testing.T) {
+	paused, active := true, false
+	contacts := []pingdom.Contact{
+		{ID: 1, Name: "alice", Teams: []pingdom.ContactTeam{{ID: 10}}},
+		{ID: 2, Name: "bob", Paused: true, Teams: []pingdom.ContactTeam{{ID: 10}, {ID: 20}}},
+		{ID: 3, Name: "carol"},
+	}
+
+	cases := []struct {
+		filter contactFilter
+		ids    []int
+	}{
+		{contactFilter{}, []int{1, 2, 3}},
+		{contactFilter{TeamID: 10}, []int{1, 2}},
+		{contactFilter{TeamID: 20}, []int{2}},
+		{contactFilter{Paused: &paused}, []int{2}},
+		{contactFilter{Paused: &active}, []int{1, 3}},
+		{contactFilter{NameRegex: regexp.MustCompile("^(alice|carol)$")}, []int{1, 3}},
+		{contactFilter{TeamID: 10, Paused: &active}, []int{1}},
+		{contactFilter{TeamID: 30}, []int{}},
+	}
+
+	for _, c := range cases {
+		ids := []int{}
+		for i := range contacts {
+			if c.filter.match(&contacts[i]) {
+				ids = append(ids, contacts[i].ID)
+			}
+		}
+		if !reflect.DeepEqual(ids, c.ids) {
+			t.Errorf("filter %+v: got %v, want %v", c.filter, ids, c.ids)
+		}
+	}
+}
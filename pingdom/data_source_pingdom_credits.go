@@ -0,0 +1,81 @@
+package pingdom
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePingdomCredits() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomCreditsRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_checks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_checks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_uptime_checks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_tms_checks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_tms_checks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_sms": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_sms_tests": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"auto_fill_sms": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePingdomCreditsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	c, err := readCredits(client)
+	if err != nil {
+		return diag.Errorf("Error retrieving credits: %s", err)
+	}
+
+	for k, v := range map[string]interface{}{
+		"check_limit":             c.CheckLimit,
+		"available_checks":        c.AvailableChecks,
+		"used_checks":             c.UsedDefault,
+		"available_uptime_checks": c.AvailableDefault,
+		"available_tms_checks":    c.AvailableTransaction,
+		"used_tms_checks":         c.UsedTransaction,
+		"available_sms":           c.AvailableSMS,
+		"available_sms_tests":     c.AvailableSMSTests,
+		"auto_fill_sms":           c.AutoFillSMS,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("credits")
+	return nil
+}
@@ -0,0 +1,32 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePingdomCredits_basic(t *testing.T) {
+	datasourceName := "data.pingdom_credits.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomCreditsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "check_limit"),
+					resource.TestCheckResourceAttrSet(datasourceName, "available_checks"),
+					resource.TestCheckResourceAttrSet(datasourceName, "used_checks"),
+					resource.TestCheckResourceAttrSet(datasourceName, "available_tms_checks"),
+					resource.TestCheckResourceAttrSet(datasourceName, "available_sms"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePingdomCreditsConfig = `
+data "pingdom_credits" "test" {}
+`
@@ -36,7 +36,10 @@ func dataSourcePingdomIntegration() *schema.Resource {
 }
 
 func dataSourcePingdomIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).PingdomExt
+	client, err := meta.(*Clients).PingdomExt("pingdom_integration")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	name := d.Get("name").(string)
 	integrations, err := client.Integrations.List()
 	log.Printf("[DEBUG] integrations : %v", integrations)
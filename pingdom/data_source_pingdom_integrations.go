@@ -2,7 +2,6 @@ package pingdom
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -29,7 +28,10 @@ func dataSourcePingdomIntegrations() *schema.Resource {
 }
 
 func dataSourcePingdomIntegrationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).PingdomExt
+	client, err := meta.(*Clients).PingdomExt("pingdom_integrations")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	integrations, err := client.Integrations.List()
 	log.Printf("[DEBUG] integrations : %v", integrations)
 	if err != nil {
@@ -37,12 +39,12 @@ func dataSourcePingdomIntegrationsRead(ctx context.Context, d *schema.ResourceDa
 	}
 	var ids []int
 	var names []string
-	for _, integration := range integrations {
-		ids = append(ids, integration.ID)
-		names = append(names, integration.UserData["name"])
+	for _, i := range filterByID(len(integrations), func(i int) int { return integrations[i].ID }, nil) {
+		ids = append(ids, integrations[i].ID)
+		names = append(names, integrations[i].UserData["name"])
 	}
 
-	d.SetId(fmt.Sprintf("%d", len(integrations)))
+	d.SetId(listID(ids))
 	if err := d.Set("ids", ids); err != nil {
 		return diag.FromErr(err)
 	}
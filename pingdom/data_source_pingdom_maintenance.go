@@ -0,0 +1,72 @@
+package pingdom
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomMaintenance() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(resourcePingdomMaintenanceSchema())
+	s["id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"description"},
+	}
+	s["description"].Optional = true
+	s["description"].AtLeastOneOf = []string{"id", "description"}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomMaintenanceRead,
+		Schema:      s,
+	}
+}
+
+func dataSourcePingdomMaintenanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	var maintenance *pingdom.MaintenanceResponse
+	if v, ok := d.GetOk("id"); ok {
+		id, err := strconv.Atoi(v.(string))
+		if err != nil {
+			return diag.Errorf("Invalid maintenance id '%s': %s", v, err)
+		}
+		if maintenance, err = client.Maintenances.Read(id); err != nil {
+			return diag.Errorf("Error retrieving maintenance: %s", err)
+		}
+	} else {
+		description := d.Get("description").(string)
+
+		maintenances, err := client.Maintenances.List()
+		if err != nil {
+			return diag.Errorf("Error retrieving list of maintenance windows: %s", err)
+		}
+
+		var matches []int
+		for i := range maintenances {
+			if maintenances[i].Description == description {
+				matches = append(matches, maintenances[i].ID)
+				maintenance = &maintenances[i]
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return diag.Errorf("Maintenance window '%s' not found", description)
+		case 1:
+		default:
+			return diag.Errorf("%d maintenance windows described as '%s' found (IDs %s), use `id` instead", len(matches), description, intListToCDString(matches))
+		}
+	}
+
+	if err := updateResourceFromMaintenanceResponse(d, maintenance); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(maintenance.ID))
+	return nil
+}
@@ -0,0 +1,59 @@
+package pingdom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePingdomMaintenance_basic(t *testing.T) {
+	resourceName := "pingdom_maintenance.test"
+	byDescriptionDatasourceName := "data.pingdom_maintenance.by_description"
+	byIDDatasourceName := "data.pingdom_maintenance.by_id"
+	description := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomMaintenanceConfig(description),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byDescriptionDatasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(byDescriptionDatasourceName, "from", resourceName, "from"),
+					resource.TestCheckResourceAttrPair(byDescriptionDatasourceName, "to", resourceName, "to"),
+					resource.TestCheckResourceAttrPair(byDescriptionDatasourceName, "recurrencetype", resourceName, "recurrencetype"),
+					resource.TestCheckResourceAttrPair(byDescriptionDatasourceName, "uptimeids.#", resourceName, "uptimeids.#"),
+					resource.TestCheckResourceAttrPair(byIDDatasourceName, "description", resourceName, "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePingdomMaintenanceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "test" {
+	name = "%[1]s"
+	host = "www.example.com"
+	type = "http"
+}
+
+resource "pingdom_maintenance" "test" {
+	description = "%[1]s"
+	from        = "2066-01-02T22:00:00+08:00"
+	to          = "2066-01-02T23:00:00+08:00"
+	uptimeids   = [pingdom_check.test.id]
+}
+
+data "pingdom_maintenance" "by_description" {
+	description = pingdom_maintenance.test.description
+}
+
+data "pingdom_maintenance" "by_id" {
+	id = pingdom_maintenance.test.id
+}
+`, description)
+}
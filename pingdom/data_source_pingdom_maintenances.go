@@ -0,0 +1,158 @@
+package pingdom
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomMaintenances() *schema.Resource {
+	maintenance := dataSourceSchemaFromResourceSchema(resourcePingdomMaintenanceSchema())
+	maintenance["id"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomMaintenancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"recurrencetype": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "day", "week", "month"}, false),
+			},
+			"uptime_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tms_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"descriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"maintenances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: maintenance},
+			},
+		},
+	}
+}
+
+// maintenanceFilter holds the filters applied to the maintenance window
+// listing. A window matches the time range if any of its occurrences, up to
+// the end of its recurrence, overlaps it.
+type maintenanceFilter struct {
+	From           int64
+	To             int64
+	RecurrenceType string
+	UptimeID       int
+	TmsID          int
+}
+
+func (f *maintenanceFilter) match(m *pingdom.MaintenanceResponse) bool {
+	end := m.To
+	if m.RecurrenceType != "none" && m.EffectiveTo > end {
+		end = m.EffectiveTo
+	}
+	if f.From != 0 && end < f.From {
+		return false
+	}
+	if f.To != 0 && m.From > f.To {
+		return false
+	}
+	if f.RecurrenceType != "" && m.RecurrenceType != f.RecurrenceType {
+		return false
+	}
+	if f.UptimeID != 0 && !containsInt(m.Checks.Uptime, f.UptimeID) {
+		return false
+	}
+	if f.TmsID != 0 && !containsInt(m.Checks.Tms, f.TmsID) {
+		return false
+	}
+	return true
+}
+
+func containsInt(l []int, i int) bool {
+	for _, v := range l {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
+
+func dataSourcePingdomMaintenancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	filter := &maintenanceFilter{
+		RecurrenceType: d.Get("recurrencetype").(string),
+		UptimeID:       d.Get("uptime_id").(int),
+		TmsID:          d.Get("tms_id").(int),
+	}
+	if v, ok, err := getTime("from", d); err != nil {
+		return diag.FromErr(err)
+	} else if ok {
+		filter.From = v
+	}
+	if v, ok, err := getTime("to", d); err != nil {
+		return diag.FromErr(err)
+	} else if ok {
+		filter.To = v
+	}
+
+	maintenances, err := client.Maintenances.List()
+	if err != nil {
+		return diag.Errorf("Error retrieving maintenance windows: %s", err)
+	}
+	matches := filterByID(len(maintenances), func(i int) int { return maintenances[i].ID }, func(i int) bool { return filter.match(&maintenances[i]) })
+
+	ids := make([]int, 0, len(matches))
+	descriptions := make([]string, 0, len(matches))
+	objects := make([]map[string]interface{}, 0, len(matches))
+	for _, i := range matches {
+		m := &maintenances[i]
+		ids = append(ids, m.ID)
+		descriptions = append(descriptions, m.Description)
+
+		object := flattenMaintenance(m)
+		object["id"] = m.ID
+		objects = append(objects, object)
+	}
+
+	d.SetId(listID(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("descriptions", descriptions); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("maintenances", objects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
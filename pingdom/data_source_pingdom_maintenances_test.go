@@ -0,0 +1,98 @@
+package pingdom
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func TestAccDataSourcePingdomMaintenances_basic(t *testing.T) {
+	datasourceName := "data.pingdom_maintenances.test"
+	description := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomMaintenancesConfig(description),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ids.0", "pingdom_maintenance.attached", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "descriptions.0", description+"-attached"),
+					resource.TestCheckResourceAttrPair(datasourceName, "maintenances.0.from", "pingdom_maintenance.attached", "from"),
+					resource.TestCheckResourceAttr(datasourceName, "maintenances.0.uptimeids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePingdomMaintenancesConfig(description string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "test" {
+	name = "%[1]s"
+	host = "www.example.com"
+	type = "http"
+}
+
+resource "pingdom_maintenance" "attached" {
+	description = "%[1]s-attached"
+	from        = "2066-01-02T22:00:00+08:00"
+	to          = "2066-01-02T23:00:00+08:00"
+	uptimeids   = [pingdom_check.test.id]
+}
+
+resource "pingdom_maintenance" "detached" {
+	description = "%[1]s-detached"
+	from        = "2066-01-02T22:00:00+08:00"
+	to          = "2066-01-02T23:00:00+08:00"
+}
+
+data "pingdom_maintenances" "test" {
+	from      = "2066-01-02T00:00:00+08:00"
+	to        = "2066-01-03T00:00:00+08:00"
+	uptime_id = pingdom_check.test.id
+
+	depends_on = [pingdom_maintenance.attached, pingdom_maintenance.detached]
+}
+`, description)
+}
+
+func TestMaintenanceFilter(t *testing.T) {
+	maintenances := []pingdom.MaintenanceResponse{
+		{ID: 1, From: 300, To: 400, RecurrenceType: "week", EffectiveTo: 2000, Checks: pingdom.MaintenanceCheckResponse{Tms: []int{20}}},
+		{ID: 2, From: 500, To: 600, RecurrenceType: "none", EffectiveTo: 600},
+		{ID: 3, From: 100, To: 200, RecurrenceType: "none", Checks: pingdom.MaintenanceCheckResponse{Uptime: []int{10}}},
+	}
+
+	cases := []struct {
+		filter maintenanceFilter
+		ids    []int
+	}{
+		{maintenanceFilter{}, []int{1, 2, 3}},
+		{maintenanceFilter{From: 150, To: 250}, []int{3}},
+		{maintenanceFilter{From: 700}, []int{1}},
+		{maintenanceFilter{To: 350}, []int{1, 3}},
+		{maintenanceFilter{RecurrenceType: "none"}, []int{2, 3}},
+		{maintenanceFilter{UptimeID: 10}, []int{3}},
+		{maintenanceFilter{TmsID: 20}, []int{1}},
+		{maintenanceFilter{UptimeID: 20}, []int{}},
+	}
+
+	for _, c := range cases {
+		ids := []int{}
+		for i := range maintenances {
+			if c.filter.match(&maintenances[i]) {
+				ids = append(ids, maintenances[i].ID)
+			}
+		}
+		if !reflect.DeepEqual(ids, c.ids) {
+			t.Errorf("filter %+v: got %v, want %v", c.filter, ids, c.ids)
+		}
+	}
+}
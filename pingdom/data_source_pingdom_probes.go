@@ -0,0 +1,200 @@
+package pingdom
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomProbes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomProbesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(probeFilterRegions, false),
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"active_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ip_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"ipv4_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ipv6_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"hostnames": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"probes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"city": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country_iso": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// probeFilter holds the probe filters applied to the listing. Only the active
+// flag is supported by the probes API.
+type probeFilter struct {
+	Region    string
+	Country   string
+	IPVersion string
+}
+
+func (f *probeFilter) match(p *pingdom.ProbeResponse) bool {
+	if f.Region != "" && p.Region != f.Region {
+		return false
+	}
+	// The country can be given by name or ISO code.
+	if f.Country != "" && !strings.EqualFold(p.Country, f.Country) && !strings.EqualFold(p.CountryISO, f.Country) {
+		return false
+	}
+	switch f.IPVersion {
+	case "ipv4":
+		return p.IP != ""
+	case "ipv6":
+		return p.IPv6 != ""
+	}
+	return true
+}
+
+func dataSourcePingdomProbesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	params := map[string]string{}
+	if d.Get("active_only").(bool) {
+		params["onlyactive"] = "true"
+	}
+
+	probes, err := client.Probes.List(params)
+	if err != nil {
+		return diag.Errorf("Error retrieving probes: %s", err)
+	}
+	filter := &probeFilter{
+		Region:    d.Get("region").(string),
+		Country:   d.Get("country").(string),
+		IPVersion: d.Get("ip_version").(string),
+	}
+	matches := filterByID(len(probes), func(i int) int { return probes[i].ID }, func(i int) bool { return filter.match(&probes[i]) })
+
+	ids := make([]int, 0, len(matches))
+	ipv4Addresses := make([]string, 0, len(matches))
+	ipv6Addresses := make([]string, 0, len(matches))
+	hostnames := make([]string, 0, len(matches))
+	objects := make([]map[string]interface{}, 0, len(matches))
+	for _, i := range matches {
+		p := &probes[i]
+		ids = append(ids, p.ID)
+		if p.IP != "" {
+			ipv4Addresses = append(ipv4Addresses, p.IP)
+		}
+		if p.IPv6 != "" {
+			ipv6Addresses = append(ipv6Addresses, p.IPv6)
+		}
+		hostnames = append(hostnames, p.Hostname)
+		objects = append(objects, map[string]interface{}{
+			"id":          p.ID,
+			"name":        p.Name,
+			"hostname":    p.Hostname,
+			"ip":          p.IP,
+			"ipv6":        p.IPv6,
+			"active":      p.Active,
+			"city":        p.City,
+			"country":     p.Country,
+			"country_iso": p.CountryISO,
+			"region":      p.Region,
+		})
+	}
+
+	d.SetId(listID(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ipv4_addresses", ipv4Addresses); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ipv6_addresses", ipv6Addresses); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hostnames", hostnames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("probes", objects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
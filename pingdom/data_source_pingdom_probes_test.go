@@ -0,0 +1,71 @@
+package pingdom
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func TestAccDataSourcePingdomProbes_basic(t *testing.T) {
+	datasourceName := "data.pingdom_probes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomProbesConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "ids.0"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ipv4_addresses.0"),
+					resource.TestCheckResourceAttrSet(datasourceName, "hostnames.0"),
+					resource.TestCheckResourceAttr(datasourceName, "probes.0.region", "EU"),
+					resource.TestCheckResourceAttr(datasourceName, "probes.0.active", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePingdomProbesConfig = `
+data "pingdom_probes" "test" {
+	region      = "EU"
+	active_only = true
+	ip_version  = "ipv4"
+}
+`
+
+func TestProbeFilter(t *testing.T) {
+	probes := []pingdom.ProbeResponse{
+		{ID: 1, Region: "NA", Country: "United States", CountryISO: "US", IP: "5.6.7.8"},
+		{ID: 2, Region: "EU", Country: "Sweden", CountryISO: "SE", IP: "9.10.11.12"},
+		{ID: 3, Region: "EU", Country: "Germany", CountryISO: "DE", IP: "1.2.3.4", IPv6: "2001:db8::1"},
+	}
+
+	cases := []struct {
+		filter probeFilter
+		ids    []int
+	}{
+		{probeFilter{}, []int{1, 2, 3}},
+		{probeFilter{Region: "EU"}, []int{2, 3}},
+		{probeFilter{Country: "germany"}, []int{3}},
+		{probeFilter{Country: "US"}, []int{1}},
+		{probeFilter{IPVersion: "ipv6"}, []int{3}},
+		{probeFilter{Region: "EU", IPVersion: "ipv4"}, []int{2, 3}},
+		{probeFilter{Region: "APAC"}, []int{}},
+	}
+
+	for _, c := range cases {
+		ids := []int{}
+		for i := range probes {
+			if c.filter.match(&probes[i]) {
+				ids = append(ids, probes[i].ID)
+			}
+		}
+		if !reflect.DeepEqual(ids, c.ids) {
+			t.Errorf("filter %+v: got %v, want %v", c.filter, ids, c.ids)
+		}
+	}
+}
@@ -2,8 +2,6 @@ package pingdom
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,12 +35,12 @@ func dataSourcePingdomTeamsRead(ctx context.Context, d *schema.ResourceData, met
 
 	var ids = make([]int, 0, len(teams))
 	var names = make([]string, 0, len(teams))
-	for _, team := range teams {
-		ids = append(ids, team.ID)
-		names = append(names, team.Name)
+	for _, i := range filterByID(len(teams), func(i int) int { return teams[i].ID }, nil) {
+		ids = append(ids, teams[i].ID)
+		names = append(names, teams[i].Name)
 	}
 
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(listID(ids))
 	if err := d.Set("ids", ids); err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,73 @@
+package pingdom
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePingdomTmsCheck() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(resourcePingdomTmsCheckSchema())
+	s["id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name"},
+	}
+	s["name"].Optional = true
+	s["name"].AtLeastOneOf = []string{"id", "name"}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomTmsCheckRead,
+		Schema:      s,
+	}
+}
+
+func dataSourcePingdomTmsCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	var id int
+	if v, ok := d.GetOk("id"); ok {
+		var err error
+		if id, err = strconv.Atoi(v.(string)); err != nil {
+			return diag.Errorf("Invalid TMS check id '%s': %s", v, err)
+		}
+	} else {
+		name := d.Get("name").(string)
+
+		checks, err := client.TMSCheck.List()
+		if err != nil {
+			return diag.Errorf("Error retrieving list of TMS checks: %s", err)
+		}
+
+		var matches []int
+		for _, check := range checks {
+			if check.Name == name {
+				matches = append(matches, check.ID)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return diag.Errorf("TMS check '%s' not found", name)
+		case 1:
+			id = matches[0]
+		default:
+			return diag.Errorf("%d TMS checks named '%s' found (IDs %s), use `id` instead", len(matches), name, intListToCDString(matches))
+		}
+	}
+
+	ck, err := client.TMSCheck.Read(id)
+	if err != nil {
+		return diag.Errorf("Error retrieving TMS check: %s", err)
+	}
+
+	if err := updateResourceFromTmsCheckResponse(d, ck); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(ck.ID))
+	return nil
+}
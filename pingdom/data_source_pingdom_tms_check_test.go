@@ -0,0 +1,61 @@
+package pingdom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePingdomTmsCheck_basic(t *testing.T) {
+	resourceName := "pingdom_tms_check.test"
+	byNameDatasourceName := "data.pingdom_tms_check.by_name"
+	byIDDatasourceName := "data.pingdom_tms_check.by_id"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomTmsCheckConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "region", resourceName, "region"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "interval", resourceName, "interval"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "steps.#", resourceName, "steps.#"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "steps.0.fn", resourceName, "steps.0.fn"),
+					resource.TestCheckResourceAttrPair(byNameDatasourceName, "tags.#", resourceName, "tags.#"),
+					resource.TestCheckResourceAttrPair(byIDDatasourceName, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePingdomTmsCheckConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pingdom_tms_check" "test" {
+  name     = "%s"
+  active   = true
+  interval = 20
+  region   = "eu"
+  tags     = ["datasource"]
+  steps {
+    args = {
+      url = "www.ibm.com"
+    }
+    fn = "go_to"
+  }
+}
+
+data "pingdom_tms_check" "by_name" {
+  name = pingdom_tms_check.test.name
+}
+
+data "pingdom_tms_check" "by_id" {
+  id = pingdom_tms_check.test.id
+}
+`, name)
+}
@@ -0,0 +1,162 @@
+package pingdom
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomTmsChecks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomTmsChecksRead,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(tmsCheckRegions, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"checks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// tmsCheckFilter holds the filters applied to the TMS check listing. Checks
+// with any of the tags match; a nil Active matches active and paused checks.
+type tmsCheckFilter struct {
+	Tags   []string
+	Active *bool
+	Region string
+}
+
+func (f *tmsCheckFilter) match(ck *pingdom.TMSCheckResponse) bool {
+	if f.Active != nil && ck.Active != *f.Active {
+		return false
+	}
+	if f.Region != "" && ck.Region != f.Region {
+		return false
+	}
+	if len(f.Tags) == 0 {
+		return true
+	}
+	for _, want := range f.Tags {
+		for _, tag := range ck.Tags {
+			if tag == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func dataSourcePingdomTmsChecksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Pingdom
+
+	filter := &tmsCheckFilter{
+		Active: getOptionalBool(d, "active"),
+		Region: d.Get("region").(string),
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		filter.Tags = expandTags(v.(*schema.Set))
+	}
+
+	checks, err := client.TMSCheck.List()
+	if err != nil {
+		return diag.Errorf("Error retrieving TMS checks: %s", err)
+	}
+	matches := filterByID(len(checks), func(i int) int { return checks[i].ID }, func(i int) bool { return filter.match(&checks[i]) })
+
+	ids := make([]int, 0, len(matches))
+	names := make([]string, 0, len(matches))
+	objects := make([]map[string]interface{}, 0, len(matches))
+	for _, i := range matches {
+		ck := &checks[i]
+		ids = append(ids, ck.ID)
+		names = append(names, ck.Name)
+		objects = append(objects, map[string]interface{}{
+			"id":       ck.ID,
+			"name":     ck.Name,
+			"type":     ck.Type,
+			"active":   ck.Active,
+			"status":   ck.Status,
+			"interval": ck.Interval,
+			"region":   ck.Region,
+			"tags":     ck.Tags,
+		})
+	}
+
+	d.SetId(listID(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("checks", objects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package pingdom
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nordcloud/go-pingdom/pingdom"
+)
+
+func TestAccDataSourcePingdomTmsChecks_basic(t *testing.T) {
+	datasourceName := "data.pingdom_tms_checks.test"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	tag := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomTmsChecksConfig(name, tag),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ids.0", "pingdom_tms_check.active", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "names.0", name+"-active"),
+					resource.TestCheckResourceAttr(datasourceName, "checks.0.region", "eu"),
+					resource.TestCheckResourceAttr(datasourceName, "checks.0.active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePingdomTmsChecksConfig(name, tag string) string {
+	return fmt.Sprintf(`
+resource "pingdom_tms_check" "active" {
+  name   = "%[1]s-active"
+  active = true
+  region = "eu"
+  tags   = ["%[2]s"]
+  steps {
+    args = {
+      url = "www.ibm.com"
+    }
+    fn = "go_to"
+  }
+}
+
+resource "pingdom_tms_check" "paused" {
+  name   = "%[1]s-paused"
+  active = false
+  region = "eu"
+  tags   = ["%[2]s"]
+  steps {
+    args = {
+      url = "www.ibm.com"
+    }
+    fn = "go_to"
+  }
+}
+
+data "pingdom_tms_checks" "test" {
+  tags   = [pingdom_tms_check.active.tags[0]]
+  active = true
+  region = "eu"
+
+  depends_on = [pingdom_tms_check.paused]
+}
+`, name, tag)
+}
+
+func TestTmsCheckFilter(t *testing.T) {
+	active, paused := true, false
+	checks := []pingdom.TMSCheckResponse{
+		{ID: 1, Active: false, Region: "eu", Tags: []string{"web"}},
+		{ID: 2, Active: true, Region: "us-east"},
+		{ID: 3, Active: true, Region: "eu", Tags: []string{"web", "eu"}},
+	}
+
+	cases := []struct {
+		filter tmsCheckFilter
+		ids    []int
+	}{
+		{tmsCheckFilter{}, []int{1, 2, 3}},
+		{tmsCheckFilter{Active: &active}, []int{2, 3}},
+		{tmsCheckFilter{Active: &paused}, []int{1}},
+		{tmsCheckFilter{Region: "eu"}, []int{1, 3}},
+		{tmsCheckFilter{Tags: []string{"eu", "missing"}}, []int{3}},
+		{tmsCheckFilter{Tags: []string{"web"}, Active: &active}, []int{3}},
+		{tmsCheckFilter{Tags: []string{"missing"}}, []int{}},
+	}
+
+	for _, c := range cases {
+		ids := []int{}
+		for i := range checks {
+			if c.filter.match(&checks[i]) {
+				ids = append(ids, checks[i].ID)
+			}
+		}
+		if !reflect.DeepEqual(ids, c.ids) {
+			t.Errorf("filter %+v: got %v, want %v", c.filter, ids, c.ids)
+		}
+	}
+}
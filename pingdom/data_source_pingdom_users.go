@@ -0,0 +1,214 @@
+package pingdom
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nordcloud/go-pingdom/solarwinds"
+)
+
+const (
+	userStatusActive  = "active"
+	userStatusInvited = "invited"
+)
+
+func dataSourcePingdomUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePingdomUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"product": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{userStatusActive, userStatusInvited}, false),
+			},
+			"emails": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_login": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"products": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"role": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// organizationUser is either an active user or a pending invitation of the
+// organization.
+type organizationUser struct {
+	Email     string
+	Status    string
+	Role      string
+	FirstName string
+	LastName  string
+	LastLogin string
+	Products  []solarwinds.Product
+}
+
+// userFilter holds the user filters, roles and product names are matched
+// regardless of case.
+type userFilter struct {
+	Role    string
+	Product string
+	Status  string
+}
+
+func (f *userFilter) match(u *organizationUser) bool {
+	if f.Role != "" && !strings.EqualFold(u.Role, f.Role) {
+		return false
+	}
+	if f.Status != "" && u.Status != f.Status {
+		return false
+	}
+	if f.Product == "" {
+		return true
+	}
+	for _, p := range u.Products {
+		if strings.EqualFold(p.Name, f.Product) {
+			return true
+		}
+	}
+	return false
+}
+
+// listOrganizationUsers returns the active users and pending invitations of
+// the organization, ordered by email.
+func listOrganizationUsers(client *solarwinds.Client) ([]organizationUser, error) {
+	activeUsers, err := client.ActiveUserService.List()
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := client.InvitationService.List()
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]organizationUser, 0, len(activeUsers.Organization.Members)+len(invitations.Organization.Invitations))
+	for _, member := range activeUsers.Organization.Members {
+		users = append(users, organizationUser{
+			Email:     member.User.Email,
+			Status:    userStatusActive,
+			Role:      member.Role,
+			FirstName: member.User.FirstName,
+			LastName:  member.User.LastName,
+			LastLogin: member.User.LastLogin,
+			Products:  member.Products,
+		})
+	}
+	for _, invitation := range invitations.Organization.Invitations {
+		users = append(users, organizationUser{
+			Email:    invitation.Email,
+			Status:   userStatusInvited,
+			Role:     invitation.Role,
+			Products: invitation.Products,
+		})
+	}
+
+	sort.Slice(users, func(i, j int) bool { return users[i].Email < users[j].Email })
+	return users, nil
+}
+
+func dataSourcePingdomUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Clients).Solarwinds("pingdom_users")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	users, err := listOrganizationUsers(client)
+	if err != nil {
+		return diag.Errorf("Error retrieving users: %s", err)
+	}
+
+	filter := &userFilter{
+		Role:    d.Get("role").(string),
+		Product: d.Get("product").(string),
+		Status:  d.Get("status").(string),
+	}
+
+	emails := make([]string, 0, len(users))
+	objects := make([]map[string]interface{}, 0, len(users))
+	for i := range users {
+		u := &users[i]
+		if !filter.match(u) {
+			continue
+		}
+		emails = append(emails, u.Email)
+		objects = append(objects, map[string]interface{}{
+			"email":      u.Email,
+			"status":     u.Status,
+			"role":       u.Role,
+			"first_name": u.FirstName,
+			"last_name":  u.LastName,
+			"last_login": u.LastLogin,
+			"products":   flattenUserProducts(u.Products),
+		})
+	}
+
+	d.SetId(Strings(emails))
+	if err := d.Set("emails", emails); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("users", objects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package pingdom
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nordcloud/go-pingdom/solarwinds"
+)
+
+func TestAccDataSourcePingdomUsers_basic(t *testing.T) {
+	datasourceName := "data.pingdom_users.test"
+	email := acctest.RandString(10) + "@foo.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePingdomUsersConfig(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(datasourceName, "emails.*", email),
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "users.*", map[string]string{
+						"email":           email,
+						"status":          "invited",
+						"role":            "MEMBER",
+						"products.0.name": "APPOPTICS",
+						"products.0.role": "MEMBER",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePingdomUsersConfig(email string) string {
+	return fmt.Sprintf(`
+resource "pingdom_user" "test" {
+	email = "%s"
+	role  = "MEMBER"
+	products {
+		name = "APPOPTICS"
+		role = "MEMBER"
+	}
+}
+
+data "pingdom_users" "test" {
+	status  = "invited"
+	product = "appoptics"
+
+	depends_on = [pingdom_user.test]
+}
+`, email)
+}
+
+func TestUserFilter(t *testing.T) {
+	users := []organizationUser{
+		{Email: "a@example.com", Status: userStatusActive, Role: "ADMIN", Products: []solarwinds.Product{{Name: "PINGDOM", Role: "ADMIN"}}},
+		{Email: "b@example.com", Status: userStatusActive, Role: "MEMBER", Products: []solarwinds.Product{{Name: "APPOPTICS", Role: "MEMBER"}}},
+		{Email: "c@example.com", Status: userStatusInvited, Role: "MEMBER", Products: []solarwinds.Product{{Name: "PINGDOM", Role: "MEMBER"}}},
+	}
+
+	cases := []struct {
+		filter userFilter
+		emails []string
+	}{
+		{userFilter{}, []string{"a@example.com", "b@example.com", "c@example.com"}},
+		{userFilter{Role: "member"}, []string{"b@example.com", "c@example.com"}},
+		{userFilter{Product: "Pingdom"}, []string{"a@example.com", "c@example.com"}},
+		{userFilter{Status: userStatusInvited}, []string{"c@example.com"}},
+		{userFilter{Role: "MEMBER", Product: "PINGDOM"}, []string{"c@example.com"}},
+		{userFilter{Product: "LOGGLY"}, []string{}},
+	}
+
+	for _, c := range cases {
+		emails := []string{}
+		for i := range users {
+			if c.filter.match(&users[i]) {
+				emails = append(emails, users[i].Email)
+			}
+		}
+		if !reflect.DeepEqual(emails, c.emails) {
+			t.Errorf("filter %+v: got %v, want %v", c.filter, emails, c.emails)
+		}
+	}
+}
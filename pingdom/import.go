@@ -0,0 +1,44 @@
+package pingdom
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Resources can be imported by their ID, or by an attribute using an import
+// ID of the form `<attribute>:<value>`, like `name:my check`. The value is
+// looked up in the resource listing and must match exactly one resource.
+
+// importLookupValue returns the value to look up when the import ID is of the
+// form `<attribute>:<value>`.
+func importLookupValue(id string, attribute string) (string, bool) {
+	prefix := attribute + ":"
+	if !strings.HasPrefix(id, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(id, prefix), true
+}
+
+// findImportID returns the ID of the only resource whose attribute matches the
+// value, given the attribute values of all resources by ID.
+func findImportID(resourceType string, attribute string, value string, values map[int]string) (string, error) {
+	var matches []int
+	for id, v := range values {
+		if v == value {
+			matches = append(matches, id)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s found with %s %q", resourceType, attribute, value)
+	case 1:
+		return strconv.Itoa(matches[0]), nil
+	}
+
+	sort.Ints(matches)
+	return "", fmt.Errorf("%d %ss found with %s %q, import one of them by ID instead: %s",
+		len(matches), resourceType, attribute, value, intListToCDString(matches))
+}
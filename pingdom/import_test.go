@@ -0,0 +1,52 @@
+package pingdom
+
+import (
+	"testing"
+)
+
+func TestImportLookupValue(t *testing.T) {
+	cases := []struct {
+		id    string
+		value string
+		ok    bool
+	}{
+		{"12345", "", false},
+		{"name:my check", "my check", true},
+		{"name:", "", true},
+		{"name:a:b", "a:b", true},
+		{"description:name:x", "", false},
+	}
+
+	for _, c := range cases {
+		value, ok := importLookupValue(c.id, "name")
+		if value != c.value || ok != c.ok {
+			t.Errorf("importLookupValue(%q) = (%q, %t), want (%q, %t)", c.id, value, ok, c.value, c.ok)
+		}
+	}
+}
+
+func TestFindImportID(t *testing.T) {
+	names := map[int]string{
+		1: "web",
+		2: "api",
+		3: "api",
+		4: "API",
+	}
+
+	id, err := findImportID("check", "name", "web", names)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if id != "1" {
+		t.Errorf("got ID %s, want 1", id)
+	}
+
+	if _, err := findImportID("check", "name", "db", names); err == nil || err.Error() != `no check found with name "db"` {
+		t.Errorf("unexpected error for missing name: %v", err)
+	}
+
+	want := `2 checks found with name "api", import one of them by ID instead: 2,3`
+	if _, err := findImportID("check", "name", "api", names); err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %s", err, want)
+	}
+}
@@ -1,9 +1,13 @@
 package pingdom
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -18,14 +22,80 @@ func Provider() *schema.Provider {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"solarwinds_passwd": {
+			"solarwinds_password": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"solarwinds_passwd": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Use solarwinds_password instead",
+				ConflictsWith: []string{"solarwinds_password"},
+			},
 			"solarwinds_org_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"api_token_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"solarwinds_password_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"shared_credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PINGDOM_SHARED_CREDENTIALS_FILE", nil),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PINGDOM_PROFILE", nil),
+			},
+			"pingdom_base_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PINGDOM_BASE_URL", nil),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"pingdomext_base_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PINGDOMEXT_BASE_URL", nil),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"solarwinds_base_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SOLARWINDS_BASE_URL", nil),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_retry_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(defaultMaxRetryWait / time.Second),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"enforce_credits_for_creations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail the plan when the account lacks the credits for the checks and TMS checks it creates. Every creation is counted, checks destroyed by the same plan aren't credited back, so this is stricter than a net count.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"pingdom_check":       resourcePingdomCheck(),
@@ -38,24 +108,44 @@ func Provider() *schema.Provider {
 			"pingdom_tms_check":   resourcePingdomTmsCheck(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"pingdom_contact":      dataSourcePingdomContact(),
-			"pingdom_contacts":     dataSourcePingdomContacts(),
-			"pingdom_team":         dataSourcePingdomTeam(),
-			"pingdom_teams":        dataSourcePingdomTeams(),
-			"pingdom_integration":  dataSourcePingdomIntegration(),
-			"pingdom_integrations": dataSourcePingdomIntegrations(),
+			"pingdom_check":         dataSourcePingdomCheck(),
+			"pingdom_check_results": dataSourcePingdomCheckResults(),
+			"pingdom_check_summary": dataSourcePingdomCheckSummary(),
+			"pingdom_checks":        dataSourcePingdomChecks(),
+			"pingdom_contact":       dataSourcePingdomContact(),
+			"pingdom_credits":       dataSourcePingdomCredits(),
+			"pingdom_contacts":      dataSourcePingdomContacts(),
+			"pingdom_team":          dataSourcePingdomTeam(),
+			"pingdom_teams":         dataSourcePingdomTeams(),
+			"pingdom_integration":   dataSourcePingdomIntegration(),
+			"pingdom_integrations":  dataSourcePingdomIntegrations(),
+			"pingdom_maintenance":   dataSourcePingdomMaintenance(),
+			"pingdom_maintenances":  dataSourcePingdomMaintenances(),
+			"pingdom_probes":        dataSourcePingdomProbes(),
+			"pingdom_tms_check":     dataSourcePingdomTmsCheck(),
+			"pingdom_tms_checks":    dataSourcePingdomTmsChecks(),
+			"pingdom_users":         dataSourcePingdomUsers(),
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var config Config
 	configRaw := d.Get("").(map[string]interface{})
 	if err := mapstructure.Decode(configRaw, &config); err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
+	}
+
+	diags := config.resolveCredentials()
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	log.Println("[INFO] Initializing Pingdom client")
-	return config.Client()
+	clients, err := config.Client()
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	return clients, diags
 }
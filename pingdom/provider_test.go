@@ -30,10 +30,6 @@ func TestProvider(t *testing.T) {
 func TestProviderConfigure(t *testing.T) {
 	var expectedToken string
 
-	var isAccTestEnabled bool
-	if v := os.Getenv("TF_ACC"); v != "" {
-		isAccTestEnabled = true
-	}
 	if v := os.Getenv("PINGDOM_API_TOKEN"); v != "" {
 		expectedToken = v
 	} else {
@@ -44,23 +40,18 @@ func TestProviderConfigure(t *testing.T) {
 		"api_token": expectedToken,
 	}
 
-	// Previously there is only one client, which is the Pingdom client. It does not require obtaining any kind of
-	// token during its initialization process, thus it will not verify whether the token provided is valid or not.
-	// However, the case is different for the Solarwinds client because it will not initialize successfully unless
-	// there are real user credentials provided.	In this case, we need to skip the init process to avoid any test
-	// errors if the credentials are not provided.
-	if isAccTestEnabled {
-		rp := Provider()
-		err := rp.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
-		if err != nil {
-			t.Fatal(err)
-		}
+	// The Solarwinds and pingdomext clients log in on first use, so configuring the provider doesn't need valid
+	// credentials.
+	rp := Provider()
+	err := rp.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
 
-		pingdomClient := rp.Meta().(*Clients).Pingdom
+	pingdomClient := rp.Meta().(*Clients).Pingdom
 
-		if pingdomClient.APIToken != expectedToken {
-			t.Fatalf("bad: %#v", pingdomClient)
-		}
+	if pingdomClient.APIToken != expectedToken {
+		t.Fatalf("bad: %#v", pingdomClient)
 	}
 }
 
@@ -71,8 +62,8 @@ func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("SOLARWINDS_USER"); v == "" {
 		t.Fatal("SOLARWINDS_USER environment variable must be set for acceptance tests")
 	}
-	if v := os.Getenv("SOLARWINDS_PASSWD"); v == "" {
-		t.Fatal("SOLARWINDS_PASSWD environment variable must be set for acceptance tests")
+	if os.Getenv("SOLARWINDS_PASSWORD") == "" && os.Getenv("SOLARWINDS_PASSWD") == "" {
+		t.Fatal("SOLARWINDS_PASSWORD environment variable must be set for acceptance tests")
 	}
 }
 
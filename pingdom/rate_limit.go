@@ -0,0 +1,186 @@
+package pingdom
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitReserve is the number of remaining requests in a Pingdom rate
+	// limit window below which requests are spread out.
+	rateLimitReserve = 50
+	// rateLimitHorizon bounds the pacing: a low budget is spread over at most
+	// this time rather than over the rest of its window, which lasts up to an
+	// hour for Req-Limit-Short and a month for Req-Limit-Long. An exhausted
+	// budget is only waited for when it resets within it.
+	rateLimitHorizon = time.Minute
+)
+
+// reqLimitRegexp matches the Req-Limit-Short and Req-Limit-Long headers, like
+// "Remaining: 394 Time until reset: 3589".
+var reqLimitRegexp = regexp.MustCompile(`Remaining:\s*(\d+)\s*Time until reset:\s*(\d+)`)
+
+// rateLimiter paces the requests of all the clients. It never starts requests
+// faster than its cap, and slows down further as the budget reported by the
+// Pingdom Req-Limit headers runs out, so that the account isn't throttled.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	// interval is the shortest time between two requests, from the cap.
+	interval time.Duration
+	// budgetInterval is the time between two requests that spreads the
+	// remaining budget over the rateLimitHorizon.
+	budgetInterval time.Duration
+	// resumeAt is the end of the window when the budget is exhausted.
+	resumeAt time.Time
+	// limit names the header behind resumeAt.
+	limit string
+	// next is the earliest start of the next request.
+	next time.Time
+
+	now func() time.Time
+}
+
+// newRateLimiter returns a limiter starting at most maxRequestsPerSecond
+// requests per second, or with no cap when it's 0.
+func newRateLimiter(maxRequestsPerSecond float64) *rateLimiter {
+	l := &rateLimiter{now: time.Now}
+	if maxRequestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / maxRequestsPerSecond)
+	}
+	return l
+}
+
+// reserve books the next request slot and returns how long to wait for it.
+// It fails without booking when the budget is exhausted beyond the
+// rateLimitHorizon.
+func (l *rateLimiter) reserve() (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if l.resumeAt.Sub(now) > rateLimitHorizon {
+		return 0, &rateLimitError{limit: l.limit, until: l.resumeAt}
+	}
+	start := now
+	if l.next.After(start) {
+		start = l.next
+	}
+	if l.resumeAt.After(start) {
+		start = l.resumeAt
+	}
+	wait := start.Sub(now)
+
+	interval := l.interval
+	if l.budgetInterval > interval {
+		interval = l.budgetInterval
+	}
+	l.next = start.Add(interval)
+	return wait, nil
+}
+
+// rateLimitError reports a request refused because a Pingdom request budget
+// is exhausted until after the rateLimitHorizon. It isn't retried.
+type rateLimitError struct {
+	// limit is the Req-Limit header that ran out.
+	limit string
+	until time.Time
+}
+
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("the Pingdom request limit %s is exhausted until %s", e.limit, e.until.Format(time.RFC3339))
+}
+
+// update reads the remaining budget from the Req-Limit headers of a response.
+// Responses without them leave the pacing as is.
+func (l *rateLimiter) update(header http.Header) {
+	var budgetInterval time.Duration
+	var resumeAt time.Time
+	var limit string
+	found := false
+
+	now := l.now()
+	for _, name := range []string{"Req-Limit-Short", "Req-Limit-Long"} {
+		remaining, reset, ok := parseReqLimit(header.Get(name))
+		if !ok {
+			continue
+		}
+		found = true
+
+		switch {
+		case remaining == 0:
+			if end := now.Add(reset); end.After(resumeAt) {
+				resumeAt = end
+				limit = name
+			}
+		case remaining <= rateLimitReserve:
+			if reset > rateLimitHorizon {
+				reset = rateLimitHorizon
+			}
+			if interval := reset / time.Duration(remaining); interval > budgetInterval {
+				budgetInterval = interval
+			}
+		}
+	}
+	if !found {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !resumeAt.IsZero() && !resumeAt.Equal(l.resumeAt) {
+		log.Printf("[WARN] Pingdom request limit %s exhausted until %s", limit, resumeAt.Format(time.RFC3339))
+	}
+	l.budgetInterval = budgetInterval
+	l.resumeAt = resumeAt
+	l.limit = limit
+}
+
+// parseReqLimit parses a Req-Limit-Short or Req-Limit-Long header into the
+// remaining number of requests and the time until the window resets.
+func parseReqLimit(v string) (int, time.Duration, bool) {
+	m := reqLimitRegexp.FindStringSubmatch(v)
+	if m == nil {
+		return 0, 0, false
+	}
+	remaining, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	reset, err := strconv.Atoi(m[2])
+	if err != nil {
+		return 0, 0, false
+	}
+	return remaining, time.Duration(reset) * time.Second, true
+}
+
+// rateLimitTransport waits for a slot of its limiter before every request and
+// feeds it the Req-Limit headers of the responses.
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	limiter *rateLimiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	wait, err := t.limiter.reserve()
+	if err != nil {
+		return nil, err
+	}
+	if wait > 0 {
+		if err := sleepContext(req, wait); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err == nil {
+		t.limiter.update(resp.Header)
+	}
+	return resp, err
+}
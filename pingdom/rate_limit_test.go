@@ -0,0 +1,177 @@
+package pingdom
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseReqLimit(t *testing.T) {
+	tests := []struct {
+		value         string
+		wantRemaining int
+		wantReset     time.Duration
+		wantOk        bool
+	}{
+		{value: "Remaining: 394 Time until reset: 3589", wantRemaining: 394, wantReset: 3589 * time.Second, wantOk: true},
+		{value: "Remaining: 0 Time until reset: 12", wantRemaining: 0, wantReset: 12 * time.Second, wantOk: true},
+		{value: "", wantOk: false},
+		{value: "Remaining: many", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		remaining, reset, ok := parseReqLimit(tt.value)
+		if remaining != tt.wantRemaining || reset != tt.wantReset || ok != tt.wantOk {
+			t.Errorf("parseReqLimit(%q) = %d, %s, %t, want %d, %s, %t", tt.value, remaining, reset, ok, tt.wantRemaining, tt.wantReset, tt.wantOk)
+		}
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(2)
+	limiter.now = func() time.Time { return now }
+
+	// The cap spaces the requests by half a second.
+	for i, want := range []time.Duration{0, 500 * time.Millisecond, time.Second} {
+		if got := mustReserve(t, limiter); got != want {
+			t.Errorf("cap: request %d waits %s, want %s", i, got, want)
+		}
+	}
+
+	// A plentiful budget doesn't slow down beyond the cap.
+	now = now.Add(10 * time.Second)
+	limiter.update(http.Header{
+		"Req-Limit-Short": []string{"Remaining: 394 Time until reset: 3589"},
+		"Req-Limit-Long":  []string{"Remaining: 71994 Time until reset: 2591989"},
+	})
+	mustReserve(t, limiter)
+	if got, want := mustReserve(t, limiter), 500*time.Millisecond; got != want {
+		t.Errorf("plentiful budget: request waits %s, want %s", got, want)
+	}
+
+	// A low budget is spread over the rest of its window.
+	now = now.Add(10 * time.Second)
+	limiter.update(http.Header{
+		"Req-Limit-Short": []string{"Remaining: 10 Time until reset: 60"},
+	})
+	mustReserve(t, limiter)
+	if got, want := mustReserve(t, limiter), 6*time.Second; got != want {
+		t.Errorf("low budget: request waits %s, want %s", got, want)
+	}
+
+	// An exhausted budget pauses the requests until the window resets.
+	now = now.Add(time.Minute)
+	limiter.update(http.Header{
+		"Req-Limit-Short": []string{"Remaining: 394 Time until reset: 3589"},
+		"Req-Limit-Long":  []string{"Remaining: 0 Time until reset: 30"},
+	})
+	if got, want := mustReserve(t, limiter), 30*time.Second; got != want {
+		t.Errorf("exhausted budget: request waits %s, want %s", got, want)
+	}
+
+	// Responses without the headers leave the pacing as is.
+	limiter.update(http.Header{})
+	if got, want := mustReserve(t, limiter), 30*time.Second+500*time.Millisecond; got != want {
+		t.Errorf("no headers: request waits %s, want %s", got, want)
+	}
+}
+
+func TestRateLimiterNoCap(t *testing.T) {
+	limiter := newRateLimiter(0)
+	for i := 0; i < 3; i++ {
+		if got := mustReserve(t, limiter); got != 0 {
+			t.Errorf("request %d waits %s, want 0", i, got)
+		}
+	}
+}
+
+func mustReserve(t *testing.T, limiter *rateLimiter) time.Duration {
+	wait, err := limiter.reserve()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return wait
+}
+
+func TestRateLimiterLongWindows(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(0)
+	limiter.now = func() time.Time { return now }
+
+	// A low budget in an hourly window is spread over a minute, not over the
+	// rest of the window.
+	limiter.update(http.Header{
+		"Req-Limit-Short": []string{"Remaining: 40 Time until reset: 3000"},
+		"Req-Limit-Long":  []string{"Remaining: 71994 Time until reset: 2591989"},
+	})
+	for i, want := range []time.Duration{0, 1500 * time.Millisecond, 3 * time.Second} {
+		if got := mustReserve(t, limiter); got != want {
+			t.Errorf("low budget: request %d waits %s, want %s", i, got, want)
+		}
+	}
+
+	// An exhausted budget resetting within a minute is waited for.
+	now = now.Add(time.Minute)
+	limiter.update(http.Header{
+		"Req-Limit-Short": []string{"Remaining: 0 Time until reset: 20"},
+	})
+	if got, want := mustReserve(t, limiter), 20*time.Second; got != want {
+		t.Errorf("short pause: request waits %s, want %s", got, want)
+	}
+
+	// A later reset fails the request, naming the limit and its reset.
+	limiter.update(http.Header{
+		"Req-Limit-Short": []string{"Remaining: 394 Time until reset: 3589"},
+		"Req-Limit-Long":  []string{"Remaining: 0 Time until reset: 3600"},
+	})
+	_, err := limiter.reserve()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, want := range []string{"Req-Limit-Long", "2021-05-01T13:01:00Z"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q doesn't contain %q", err, want)
+		}
+	}
+
+	// The failed request doesn't book a slot.
+	now = now.Add(time.Hour)
+	if got := mustReserve(t, limiter); got != 0 {
+		t.Errorf("after the reset: request waits %s, want 0", got)
+	}
+}
+
+func TestRateLimitErrorIsNotRetried(t *testing.T) {
+	calls := 0
+	limiter := newRateLimiter(0)
+	limiter.update(http.Header{
+		"Req-Limit-Long": []string{"Remaining: 0 Time until reset: 3600"},
+	})
+	transport := newRetryTransport(&rateLimitTransport{base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return nil, nil
+	}), limiter: limiter}, 3, 10*time.Second)
+	transport.sleep = func(req *http.Request, d time.Duration) error {
+		t.Error("unexpected retry")
+		return nil
+	}
+
+	req, err := http.NewRequest("GET", "http://localhost/checks", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Error("expected an error")
+	}
+	if calls != 0 {
+		t.Errorf("calls = %d, want 0", calls)
+	}
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
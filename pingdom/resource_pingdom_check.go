@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nordcloud/go-pingdom/pingdom"
 )
 
@@ -19,219 +23,674 @@ func resourcePingdomCheck() *schema.Resource {
 		ReadContext:   resourcePingdomCheckRead,
 		UpdateContext: resourcePingdomCheckUpdate,
 		DeleteContext: resourcePingdomCheckDelete,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePingdomCheckCustomizeDiff,
+			customizeDiffReserveCheckCredit,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourcePingdomCheckImport,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourcePingdomCheckV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeTagsStringToSet,
+				Version: 0,
+			},
+		},
+		Schema: resourcePingdomCheckSchema(),
+	}
+}
 
+func resourcePingdomCheckSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: false,
+		},
+		"host": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: false,
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(checkTypes, false),
+		},
+		"paused": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"responsetime_threshold": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"resolution": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"sendnotificationwhendown": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"notifyagainevery": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"notifywhenbackup": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"integrationids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: false,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"encryption": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"url": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         false,
+			Default:          "/",
+			DiffSuppressFunc: diffSuppressIfNotHTTPOrHTTPCustomCheck,
+		},
+		"additionalurls": {
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: false,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"username": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			ForceNew:  false,
+			Sensitive: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			ForceNew:  false,
+			Sensitive: true,
+		},
+		"shouldcontain": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+		},
+		"shouldnotcontain": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+		},
+		"postdata": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+		},
+		"requestheaders": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: false,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"sensitive_requestheaders": {
+			Type:      schema.TypeMap,
+			Optional:  true,
+			ForceNew:  false,
+			Sensitive: true,
+			Elem:      &schema.Schema{Type: schema.TypeString},
+		},
+		"tags": tagsSchema(),
+		"probefilters": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ForceNew:      false,
+			Deprecated:    "use the probe_filter block instead",
+			ConflictsWith: []string{"probe_filter"},
+			StateFunc: func(val interface{}) string {
+				return normaliseProbeFilters([]string{val.(string)})
+			},
+		},
+		"probe_filter": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			ForceNew:      false,
+			MaxItems:      1,
+			ConflictsWith: []string{"probefilters"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"regions": {
+						Type:     schema.TypeSet,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(probeFilterRegions, false),
+						},
+					},
+				},
+			},
+		},
+		"userids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: false,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"teamids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: false,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"stringtosend": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+		},
+		"stringtoexpect": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+		},
+		"expectedip": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+		},
+		"nameserver": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+		},
+		"verify_certificate": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			ForceNew:         false,
+			Default:          true,
+			DiffSuppressFunc: diffSuppressIfNotHTTPCheck,
+		},
+		"ssl_down_days_before": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"lasttesttime": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"lasterrortime": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"lastresponsetime": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"lastdownstart": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"lastdownend": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+type commonCheckParams struct {
+	Name                     string
+	Hostname                 string
+	Resolution               int
+	Paused                   bool
+	ResponseTimeThreshold    int
+	SendNotificationWhenDown int
+	NotifyAgainEvery         int
+	NotifyWhenBackup         bool
+	IntegrationIds           []int
+	UserIds                  []int
+	TeamIds                  []int
+	Url                      string
+	AdditionalUrls           []string
+	Encryption               bool
+	Port                     int
+	Username                 string
+	Password                 string
+	ShouldContain            string
+	ShouldNotContain         string
+	PostData                 string
+	RequestHeaders           map[string]string
+	Tags                     string
+	ProbeFilters             string
+	StringToSend             string
+	StringToExpect           string
+	ExpectedIP               string
+	NameServer               string
+	VerifyCertificate        bool
+	SSLDownDaysBefore        int
+}
+
+func (p *commonCheckParams) toBaseCheck() baseCheck {
+	return baseCheck{
+		Name:                     p.Name,
+		Hostname:                 p.Hostname,
+		Resolution:               p.Resolution,
+		Paused:                   p.Paused,
+		ResponseTimeThreshold:    p.ResponseTimeThreshold,
+		SendNotificationWhenDown: p.SendNotificationWhenDown,
+		NotifyAgainEvery:         p.NotifyAgainEvery,
+		NotifyWhenBackup:         p.NotifyWhenBackup,
+		IntegrationIds:           p.IntegrationIds,
+		Tags:                     p.Tags,
+		ProbeFilters:             p.ProbeFilters,
+		UserIds:                  p.UserIds,
+		TeamIds:                  p.TeamIds,
+	}
+}
+
+// checkTypeAttributes lists the type specific attributes each check type
+// supports. The url and verify_certificate attributes are left out as they
+// carry defaults and their diffs are already suppressed for other types.
+var checkTypeAttributes = map[string][]string{
+	"http":       {"encryption", "port", "username", "password", "shouldcontain", "shouldnotcontain", "postdata", "requestheaders", "sensitive_requestheaders", "ssl_down_days_before"},
+	"httpcustom": {"encryption", "port", "username", "password", "additionalurls"},
+	"ping":       {},
+	"tcp":        {"port", "stringtosend", "stringtoexpect"},
+	"udp":        {"port", "stringtosend", "stringtoexpect"},
+	"dns":        {"expectedip", "nameserver"},
+	"smtp":       {"encryption", "port", "username", "password", "stringtoexpect"},
+	"pop3":       {"encryption", "port", "stringtoexpect"},
+	"imap":       {"encryption", "port", "stringtoexpect"},
+}
+
+// checkTypes are the supported values of the type attribute.
+var checkTypes = []string{"http", "httpcustom", "ping", "tcp", "udp", "dns", "smtp", "pop3", "imap"}
+
+// requiredCheckTypeAttributes lists the attributes a check type can't do
+// without.
+var requiredCheckTypeAttributes = map[string][]string{
+	"tcp": {"port"},
+	"udp": {"port", "stringtosend", "stringtoexpect"},
+	"dns": {"expectedip", "nameserver"},
+}
+
+// duplicateRequestHeaders returns the sorted names of the headers set in both
+// header maps.
+func duplicateRequestHeaders(headers, sensitiveHeaders map[string]interface{}) []string {
+	var duplicates []string
+	for k := range sensitiveHeaders {
+		if _, ok := headers[k]; ok {
+			duplicates = append(duplicates, k)
+		}
+	}
+	sort.Strings(duplicates)
+	return duplicates
+}
+
+// resourcePingdomCheckCustomizeDiff rejects type specific attributes that don't
+// apply to the check type, which checkForResource would otherwise silently
+// drop, and requires the ones the type needs.
+func resourcePingdomCheckCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("requestheaders") && d.NewValueKnown("sensitive_requestheaders") {
+		headers := duplicateRequestHeaders(d.Get("requestheaders").(map[string]interface{}), d.Get("sensitive_requestheaders").(map[string]interface{}))
+		if len(headers) > 0 {
+			return fmt.Errorf("request headers set in both requestheaders and sensitive_requestheaders: %s", strings.Join(headers, ", "))
+		}
+	}
+
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	checkType := d.Get("type").(string)
+	supported, ok := checkTypeAttributes[checkType]
+	if !ok {
+		// rejected by the validation of type
+		return nil
+	}
+
+	isSupported := map[string]bool{}
+	for _, attr := range supported {
+		isSupported[attr] = true
+	}
+
+	checkSchema := resourcePingdomCheckSchema()
+	var errs []string
+	for _, attrs := range checkTypeAttributes {
+		for _, attr := range attrs {
+			if isSupported[attr] || !checkAttributeSet(d, attr, checkSchema[attr].Computed) {
+				continue
+			}
+			isSupported[attr] = true // report each attribute once
+			errs = append(errs, fmt.Sprintf("%s: not supported for checks of type %q", attr, checkType))
+		}
+	}
+
+	for _, attr := range requiredCheckTypeAttributes[checkType] {
+		if !d.NewValueKnown(attr) {
+			continue
+		}
+		if _, ok := d.GetOk(attr); !ok {
+			errs = append(errs, fmt.Sprintf("%s: required for checks of type %q", attr, checkType))
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// checkAttributeSet reports whether the attribute has a known, non-empty value
+// in the plan. Computed attributes keep their prior state when they are
+// removed from the configuration, so for existing checks they only count when
+// they change.
+func checkAttributeSet(d *schema.ResourceDiff, attr string, computed bool) bool {
+	if !d.NewValueKnown(attr) {
+		return false
+	}
+	if _, ok := d.GetOk(attr); !ok {
+		return false
+	}
+	if computed && d.Id() != "" {
+		return d.HasChange(attr)
+	}
+	return true
+}
+
+func diffSuppressIfNotHTTPCheck(k string, old string, new string, d *schema.ResourceData) bool {
+	return d.Get("type").(string) != "http"
+}
+
+func diffSuppressIfNotHTTPOrHTTPCustomCheck(k string, old string, new string, d *schema.ResourceData) bool {
+	checkType := d.Get("type").(string)
+	return checkType != "http" && checkType != "httpcustom"
+}
+
+// tagPattern matches a tag name without whitespace or commas, as the API
+// takes the tags as a comma separated list. Other characters are left for
+// Pingdom to judge, like the ':' of "service:payments".
+var tagPattern = regexp.MustCompile(`^[^,\s]+$`)
+
+func tagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 64),
+				validation.StringMatch(tagPattern, "may not contain whitespace or commas"),
+			),
+		},
+	}
+}
+
+// expandTags returns the tags of a set, sorted alphabetically.
+func expandTags(s *schema.Set) []string {
+	tags := make([]string, 0, s.Len())
+	for _, tag := range s.List() {
+		tags = append(tags, tag.(string))
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// resourcePingdomCheckV0 is the check schema before tags became a set. It's
+// a frozen copy, only its type matters to the state upgrade.
+func resourcePingdomCheckV0() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: false,
 			},
 			"host": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: false,
 			},
 			"type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"paused": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: false,
 				Computed: true,
 			},
 			"responsetime_threshold": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: false,
 				Computed: true,
 			},
 			"resolution": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: false,
 				Computed: true,
 			},
 			"sendnotificationwhendown": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: false,
 				Computed: true,
 			},
 			"notifyagainevery": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: false,
 				Computed: true,
 			},
 			"notifywhenbackup": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: false,
 				Computed: true,
 			},
 			"integrationids": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: false,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
 			"encryption": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: false,
 				Computed: true,
 			},
 			"url": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         false,
-				Default:          "/",
-				DiffSuppressFunc: diffSuppressIfNotHTTPCheck,
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"port": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: false,
 				Computed: true,
 			},
 			"username": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"password": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"shouldcontain": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"shouldnotcontain": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"postdata": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"requestheaders": {
 				Type:     schema.TypeMap,
 				Optional: true,
-				ForceNew: false,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"tags": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
-				StateFunc: func(val interface{}) string {
-					return sortString(val.(string), ",")
-				},
 			},
 			"probefilters": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"userids": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: false,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
 			"teamids": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: false,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
 			"stringtosend": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"stringtoexpect": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"expectedip": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"nameserver": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
 			},
 			"verify_certificate": {
-				Type:             schema.TypeBool,
-				Optional:         true,
-				ForceNew:         false,
-				Default:          true,
-				DiffSuppressFunc: diffSuppressIfNotHTTPCheck,
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 			"ssl_down_days_before": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: false,
 				Computed: true,
 			},
 		},
 	}
 }
 
-type commonCheckParams struct {
-	Name                     string
-	Hostname                 string
-	Resolution               int
-	Paused                   bool
-	ResponseTimeThreshold    int
-	SendNotificationWhenDown int
-	NotifyAgainEvery         int
-	NotifyWhenBackup         bool
-	IntegrationIds           []int
-	UserIds                  []int
-	TeamIds                  []int
-	Url                      string
-	Encryption               bool
-	Port                     int
-	Username                 string
-	Password                 string
-	ShouldContain            string
-	ShouldNotContain         string
-	PostData                 string
-	RequestHeaders           map[string]string
-	Tags                     string
-	ProbeFilters             string
-	StringToSend             string
-	StringToExpect           string
-	ExpectedIP               string
-	NameServer               string
-	VerifyCertificate        bool
-	SSLDownDaysBefore        int
+// upgradeTagsStringToSet migrates the comma separated tags string of version 0
+// to a list of unique, trimmed and non-empty tags.
+func upgradeTagsStringToSet(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	tags := []interface{}{}
+	if v, ok := rawState["tags"].(string); ok {
+		seen := map[string]bool{}
+		for _, tag := range strings.Split(v, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "" || seen[tag] {
+				continue
+			}
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	rawState["tags"] = tags
+
+	return rawState, nil
 }
 
-func diffSuppressIfNotHTTPCheck(k string, old string, new string, d *schema.ResourceData) bool {
-	return d.Get("type").(string) != "http"
+// probeFilterRegions are the regions Pingdom probes can be filtered by.
+var probeFilterRegions = []string{"NA", "EU", "APAC", "LATAM"}
+
+// normaliseProbeFilters turns the probe filters returned by the API, like
+// "region: NA", into the sorted, comma separated "region:NA" form.
+func normaliseProbeFilters(filters []string) string {
+	normalised := []string{}
+	for _, filter := range filters {
+		for _, item := range strings.Split(filter, ",") {
+			key, value := splitProbeFilter(item)
+			if key == "" {
+				continue
+			}
+			normalised = append(normalised, key+":"+value)
+		}
+	}
+	sort.Strings(normalised)
+	return strings.Join(normalised, ",")
 }
 
-func sortString(input string, seperator string) string {
-	list := strings.Split(input, seperator)
-	sort.Strings(list)
-	return strings.Join(list, seperator)
+func splitProbeFilter(filter string) (string, string) {
+	parts := strings.SplitN(filter, ":", 2)
+	if len(parts) != 2 {
+		return strings.TrimSpace(filter), ""
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+func expandProbeFilter(l []interface{}) string {
+	if len(l) == 0 || l[0] == nil {
+		return ""
+	}
+
+	filters := []string{}
+	for _, region := range l[0].(map[string]interface{})["regions"].(*schema.Set).List() {
+		filters = append(filters, "region:"+region.(string))
+	}
+	return normaliseProbeFilters(filters)
+}
+
+func flattenProbeFilters(filters []string) []interface{} {
+	regions := []interface{}{}
+	for _, filter := range filters {
+		for _, item := range strings.Split(filter, ",") {
+			if key, value := splitProbeFilter(item); key == "region" && value != "" {
+				regions = append(regions, value)
+			}
+		}
+	}
+	if len(regions) == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"regions": schema.NewSet(schema.HashString, regions),
+		},
+	}
 }
 
 func checkForResource(d *schema.ResourceData) (pingdom.Check, error) {
@@ -300,6 +759,12 @@ func checkForResource(d *schema.ResourceData) (pingdom.Check, error) {
 		checkParams.Url = v.(string)
 	}
 
+	if v, ok := d.GetOk("additionalurls"); ok {
+		for _, url := range v.([]interface{}) {
+			checkParams.AdditionalUrls = append(checkParams.AdditionalUrls, url.(string))
+		}
+	}
+
 	if v, ok := d.GetOk("encryption"); ok {
 		checkParams.Encryption = v.(bool)
 	}
@@ -334,15 +799,28 @@ func checkForResource(d *schema.ResourceData) (pingdom.Check, error) {
 			checkParams.RequestHeaders[k] = v.(string)
 		}
 	}
+
+	if m, ok := d.GetOk("sensitive_requestheaders"); ok {
+		if checkParams.RequestHeaders == nil {
+			checkParams.RequestHeaders = make(map[string]string)
+		}
+		// headers set in both maps are rejected by the CustomizeDiff
+		for k, v := range m.(map[string]interface{}) {
+			checkParams.RequestHeaders[k] = v.(string)
+		}
+	}
 	if v, ok := d.GetOk("tags"); ok {
-		// Sort alphabetically before continuing
-		checkParams.Tags = sortString(v.(string), ",")
+		checkParams.Tags = strings.Join(expandTags(v.(*schema.Set)), ",")
 	}
 
 	if v, ok := d.GetOk("probefilters"); ok {
 		checkParams.ProbeFilters = v.(string)
 	}
 
+	if v, ok := d.GetOk("probe_filter"); ok {
+		checkParams.ProbeFilters = expandProbeFilter(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("stringtosend"); ok {
 		checkParams.StringToSend = v.(string)
 	}
@@ -447,6 +925,40 @@ func checkForResource(d *schema.ResourceData) (pingdom.Check, error) {
 			UserIds:                  checkParams.UserIds,
 			TeamIds:                  checkParams.TeamIds,
 		}, nil
+	case "smtp":
+		return &smtpCheck{
+			baseCheck:      checkParams.toBaseCheck(),
+			Port:           checkParams.Port,
+			Username:       checkParams.Username,
+			Password:       checkParams.Password,
+			Encryption:     checkParams.Encryption,
+			StringToExpect: checkParams.StringToExpect,
+		}, nil
+	case "pop3", "imap":
+		return &mailboxCheck{
+			baseCheck:      checkParams.toBaseCheck(),
+			Type:           checkType.(string),
+			Port:           checkParams.Port,
+			Encryption:     checkParams.Encryption,
+			StringToExpect: checkParams.StringToExpect,
+		}, nil
+	case "httpcustom":
+		return &httpCustomCheck{
+			baseCheck:      checkParams.toBaseCheck(),
+			Url:            checkParams.Url,
+			Encryption:     checkParams.Encryption,
+			Port:           checkParams.Port,
+			Username:       checkParams.Username,
+			Password:       checkParams.Password,
+			AdditionalUrls: checkParams.AdditionalUrls,
+		}, nil
+	case "udp":
+		return &udpCheck{
+			baseCheck:      checkParams.toBaseCheck(),
+			Port:           checkParams.Port,
+			StringToSend:   checkParams.StringToSend,
+			StringToExpect: checkParams.StringToExpect,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown type for check '%v'", checkType)
 	}
@@ -460,12 +972,13 @@ func resourcePingdomCheckCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] Check create configuration: %#v, %#v", d.Get("name"), d.Get("hostname"))
+	log.Printf("[DEBUG] Check create configuration: %#v, %#v", d.Get("name"), d.Get("host"))
 
 	ck, err := client.Checks.Create(check)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	meta.(*Clients).checks.Invalidate()
 
 	d.SetId(strconv.Itoa(ck.ID))
 
@@ -479,70 +992,95 @@ func resourcePingdomCheckRead(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.Errorf("Error retrieving id for resource: %s", err)
 	}
-	cl, err := client.Checks.List()
+	exists, err := meta.(*Clients).checks.Exists(id)
 	if err != nil {
 		return diag.Errorf("Error retrieving list of checks: %s", err)
 	}
-	exists := false
-	for _, ckid := range cl {
-		if ckid.ID == id {
-			exists = true
-			break
-		}
-	}
 	if !exists {
 		d.SetId("")
 		return nil
 	}
-	ck, err := client.Checks.Read(id)
+	ck, details, err := readCheck(client, id)
 	if err != nil {
 		return diag.Errorf("Error retrieving check: %s", err)
 	}
 
-	if err := d.Set("host", ck.Hostname); err != nil {
+	if err := updateResourceFromCheckResponse(d, ck, details); err != nil {
 		return diag.FromErr(err)
 	}
 
+	return nil
+}
+
+// updateResourceFromCheckResponse maps a detailed check response to the check
+// attributes. It is shared with the pingdom_check data source.
+func updateResourceFromCheckResponse(d *schema.ResourceData, ck *pingdom.CheckResponse, details *checkExtraDetails) error {
+	if err := d.Set("host", ck.Hostname); err != nil {
+		return err
+	}
+
 	if err := d.Set("name", ck.Name); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("resolution", ck.Resolution); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("responsetime_threshold", ck.ResponseTimeThreshold); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("sendnotificationwhendown", ck.SendNotificationWhenDown); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("notifyagainevery", ck.NotifyAgainEvery); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("notifywhenbackup", ck.NotifyWhenBackup); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
-	tags := []string{}
+	tags := schema.NewSet(schema.HashString, []interface{}{})
 	for _, tag := range ck.Tags {
-		tags = append(tags, tag.Name)
+		tags.Add(tag.Name)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return err
 	}
 
-	// We need to sort the strings here as the pingdom API returns them sorted by
-	//number of occurances across all checks
-	sort.Strings(tags)
-	if err := d.Set("tags", strings.Join(tags, ",")); err != nil {
-		return diag.FromErr(err)
+	if err := d.Set("paused", ck.Status == "paused"); err != nil {
+		return err
 	}
 
-	if ck.Status == "paused" {
-		if err := d.Set("paused", true); err != nil {
-			return diag.FromErr(err)
-		}
+	if err := d.Set("status", ck.Status); err != nil {
+		return err
+	}
+
+	if err := d.Set("created", optionalTimeFormat(ck.Created)); err != nil {
+		return err
+	}
+
+	if err := d.Set("lasttesttime", optionalTimeFormat(ck.LastTestTime)); err != nil {
+		return err
+	}
+
+	if err := d.Set("lasterrortime", optionalTimeFormat(ck.LastErrorTime)); err != nil {
+		return err
+	}
+
+	if err := d.Set("lastresponsetime", ck.LastResponseTime); err != nil {
+		return err
+	}
+
+	if err := d.Set("lastdownstart", optionalTimeFormat(details.LastDownStart)); err != nil {
+		return err
+	}
+
+	if err := d.Set("lastdownend", optionalTimeFormat(details.LastDownEnd)); err != nil {
+		return err
 	}
 
 	integids := schema.NewSet(
@@ -553,7 +1091,7 @@ func resourcePingdomCheckRead(ctx context.Context, d *schema.ResourceData, meta
 		integids.Add(integrationId)
 	}
 	if err := d.Set("integrationids", integids); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	userids := schema.NewSet(
@@ -564,7 +1102,7 @@ func resourcePingdomCheckRead(ctx context.Context, d *schema.ResourceData, meta
 		userids.Add(userId)
 	}
 	if err := d.Set("userids", userids); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	teamids := schema.NewSet(
@@ -575,52 +1113,55 @@ func resourcePingdomCheckRead(ctx context.Context, d *schema.ResourceData, meta
 		teamids.Add(userId)
 	}
 	if err := d.Set("teamids", teamids); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
-	if probefilters := ck.ProbeFilters; len(probefilters) > 0 {
-		// normalise: "region: NA" -> "region:NA"
-		if err := d.Set("probefilters", strings.Replace(probefilters[0], ": ", ":", 1)); err != nil {
-			return diag.FromErr(err)
+	// Only keep the deprecated string form in sync when it is the one in use,
+	// otherwise the filters are read into the probe_filter block.
+	if _, ok := d.GetOk("probefilters"); ok {
+		if err := d.Set("probefilters", normaliseProbeFilters(ck.ProbeFilters)); err != nil {
+			return err
 		}
+	} else if err := d.Set("probe_filter", flattenProbeFilters(ck.ProbeFilters)); err != nil {
+		return err
 	}
 
 	if ck.Type.HTTP != nil {
 		if err := d.Set("type", "http"); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("responsetime_threshold", ck.ResponseTimeThreshold); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("url", ck.Type.HTTP.Url); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("encryption", ck.Type.HTTP.Encryption); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("port", ck.Type.HTTP.Port); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("username", ck.Type.HTTP.Username); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("password", ck.Type.HTTP.Password); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("shouldcontain", ck.Type.HTTP.ShouldContain); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("shouldnotcontain", ck.Type.HTTP.ShouldNotContain); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("postdata", ck.Type.HTTP.PostData); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("verify_certificate", ck.Type.HTTP.VerifyCertificate); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("ssl_down_days_before", ck.Type.HTTP.SSLDownDaysBefore); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
 		if v, ok := ck.Type.HTTP.RequestHeaders["User-Agent"]; ok {
@@ -628,38 +1169,125 @@ func resourcePingdomCheckRead(ctx context.Context, d *schema.ResourceData, meta
 				delete(ck.Type.HTTP.RequestHeaders, "User-Agent")
 			}
 		}
-		if err := d.Set("requestheaders", ck.Type.HTTP.RequestHeaders); err != nil {
-			return diag.FromErr(err)
+		headers, sensitiveHeaders := splitSensitiveRequestHeaders(ck.Type.HTTP.RequestHeaders, d.Get("requestheaders").(map[string]interface{}), d.Get("sensitive_requestheaders").(map[string]interface{}))
+		if err := d.Set("requestheaders", headers); err != nil {
+			return err
+		}
+		if err := d.Set("sensitive_requestheaders", sensitiveHeaders); err != nil {
+			return err
 		}
 	} else if ck.Type.TCP != nil {
 		if err := d.Set("type", "tcp"); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("port", ck.Type.TCP.Port); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("stringtosend", ck.Type.TCP.StringToSend); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("stringtoexpect", ck.Type.TCP.StringToExpect); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	} else if ck.Type.DNS != nil {
 		if err := d.Set("type", "dns"); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("expectedip", ck.Type.DNS.ExpectedIP); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		if err := d.Set("nameserver", ck.Type.DNS.NameServer); err != nil {
-			return diag.FromErr(err)
+			return err
+		}
+	} else if isExtendedCheckType(ck.Type.Name) {
+		if err := d.Set("type", ck.Type.Name); err != nil {
+			return err
+		}
+		if err := setCheckTypeDetails(d, &details.Type); err != nil {
+			return err
 		}
 	} else {
 		if err := d.Set("type", "ping"); err != nil {
-			return diag.FromErr(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// credentialRequestHeaders are the headers known to carry credentials, in
+// canonical form.
+var credentialRequestHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"X-Api-Key":           true,
+	"X-Auth-Token":        true,
+	"X-Access-Token":      true,
+}
+
+// splitSensitiveRequestHeaders separates the headers returned by the API into
+// plain and sensitive ones. Headers keep the map they have in the current
+// state, and the others, like on import, are sensitive when they are known to
+// carry credentials.
+func splitSensitiveRequestHeaders(headers map[string]string, plainState, sensitiveState map[string]interface{}) (map[string]string, map[string]string) {
+	plain := map[string]string{}
+	secret := map[string]string{}
+	for k, v := range headers {
+		_, isPlain := plainState[k]
+		_, isSensitive := sensitiveState[k]
+		if isSensitive || (!isPlain && credentialRequestHeaders[http.CanonicalHeaderKey(k)]) {
+			secret[k] = v
+		} else {
+			plain[k] = v
+		}
+	}
+	return plain, secret
+}
+
+func setCheckTypeDetails(d *schema.ResourceData, details *checkTypeDetails) error {
+	var values map[string]interface{}
+	switch {
+	case details.SMTP != nil:
+		values = map[string]interface{}{
+			"port":           details.SMTP.Port,
+			"encryption":     details.SMTP.Encryption,
+			"username":       details.SMTP.Username,
+			"password":       details.SMTP.Password,
+			"stringtoexpect": details.SMTP.StringToExpect,
+		}
+	case details.POP3 != nil, details.IMAP != nil:
+		mailbox := details.POP3
+		if mailbox == nil {
+			mailbox = details.IMAP
+		}
+		values = map[string]interface{}{
+			"port":           mailbox.Port,
+			"encryption":     mailbox.Encryption,
+			"stringtoexpect": mailbox.StringToExpect,
+		}
+	case details.HTTPCustom != nil:
+		values = map[string]interface{}{
+			"url":            details.HTTPCustom.Url,
+			"encryption":     details.HTTPCustom.Encryption,
+			"port":           details.HTTPCustom.Port,
+			"username":       details.HTTPCustom.Username,
+			"password":       details.HTTPCustom.Password,
+			"additionalurls": details.HTTPCustom.AdditionalUrls,
+		}
+	case details.UDP != nil:
+		values = map[string]interface{}{
+			"port":           details.UDP.Port,
+			"stringtosend":   details.UDP.StringToSend,
+			"stringtoexpect": details.UDP.StringToExpect,
 		}
 	}
 
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -698,6 +1326,32 @@ func resourcePingdomCheckDelete(ctx context.Context, d *schema.ResourceData, met
 	if err != nil {
 		return diag.Errorf("Error deleting check: %s", err)
 	}
+	meta.(*Clients).checks.Invalidate()
 
 	return nil
 }
+
+// resourcePingdomCheckImport imports a check by ID or by `name:<value>`.
+func resourcePingdomCheckImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, ok := importLookupValue(d.Id(), "name")
+	if !ok {
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	checks, err := meta.(*Clients).Pingdom.Checks.List()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving list of checks: %s", err)
+	}
+	names := make(map[int]string, len(checks))
+	for _, check := range checks {
+		names[check.ID] = check.Name
+	}
+
+	id, err := findImportID("check", "name", name, names)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
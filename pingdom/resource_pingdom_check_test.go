@@ -1,13 +1,16 @@
 package pingdom
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -42,7 +45,7 @@ func TestAccResourcePingdomCheck_http(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "responsetime_threshold", "30000"),
 					resource.TestCheckResourceAttr(resourceName, "postdata", ""),
 					resource.TestCheckResourceAttr(resourceName, "integrationids.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "tags", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "probefilters.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "userids.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "teamids.#", "0"),
@@ -53,6 +56,12 @@ func TestAccResourcePingdomCheck_http(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccResourcePingdomCheckConfig_http_update(updatedName),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -71,15 +80,26 @@ func TestAccResourcePingdomCheck_http(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "port", "443"),
 					resource.TestCheckResourceAttr(resourceName, "requestheaders.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "requestheaders.X-Test-Data", "test"),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_requestheaders.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_requestheaders.Authorization", "Bearer secret"),
 					resource.TestCheckResourceAttr(resourceName, "postdata", "test message"),
 					resource.TestCheckResourceAttr(resourceName, "integrationids.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "tags", "a,b"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "a"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "b"),
 					resource.TestCheckResourceAttr(resourceName, "probefilters", "region:APAC"),
 					resource.TestCheckResourceAttr(resourceName, "paused", "true"),
 					resource.TestCheckResourceAttrPair(resourceName, "userids.0", contactResourceName, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "teamids.0", teamResourceName, "id"),
 				),
 			},
+			{
+				// The imported Authorization header is sensitive without any
+				// prior state.
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -201,6 +221,441 @@ func TestAccResourcePingdomCheck_dns(t *testing.T) {
 	})
 }
 
+func TestAccResourcePingdomCheck_smtp(t *testing.T) {
+	resourceName := "pingdom_check.smtp"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	updatedName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPingdomCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePingdomCheckConfig_smtp(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "host", "smtp.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "type", "smtp"),
+					resource.TestCheckResourceAttr(resourceName, "port", "25"),
+					resource.TestCheckResourceAttr(resourceName, "encryption", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourcePingdomCheckConfig_smtp_update(updatedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+					resource.TestCheckResourceAttr(resourceName, "host", "smtp.example.org"),
+					resource.TestCheckResourceAttr(resourceName, "type", "smtp"),
+					resource.TestCheckResourceAttr(resourceName, "port", "465"),
+					resource.TestCheckResourceAttr(resourceName, "encryption", "true"),
+					resource.TestCheckResourceAttr(resourceName, "username", "user"),
+					resource.TestCheckResourceAttr(resourceName, "stringtoexpect", "220"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePingdomCheck_pop3(t *testing.T) {
+	resourceName := "pingdom_check.pop3"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPingdomCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePingdomCheckConfig_mailbox("pop3", name, 995),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "pop3"),
+					resource.TestCheckResourceAttr(resourceName, "port", "995"),
+					resource.TestCheckResourceAttr(resourceName, "encryption", "true"),
+					resource.TestCheckResourceAttr(resourceName, "stringtoexpect", "+OK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePingdomCheck_imap(t *testing.T) {
+	resourceName := "pingdom_check.imap"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPingdomCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePingdomCheckConfig_mailbox("imap", name, 993),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "imap"),
+					resource.TestCheckResourceAttr(resourceName, "port", "993"),
+					resource.TestCheckResourceAttr(resourceName, "encryption", "true"),
+					resource.TestCheckResourceAttr(resourceName, "stringtoexpect", "+OK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePingdomCheck_udp(t *testing.T) {
+	resourceName := "pingdom_check.udp"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	updatedName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPingdomCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePingdomCheckConfig_udp(name, "www.example.com", 53),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "host", "www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "type", "udp"),
+					resource.TestCheckResourceAttr(resourceName, "port", "53"),
+					resource.TestCheckResourceAttr(resourceName, "stringtosend", "ping"),
+					resource.TestCheckResourceAttr(resourceName, "stringtoexpect", "pong"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourcePingdomCheckConfig_udp(updatedName, "www.example.org", 5060),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+					resource.TestCheckResourceAttr(resourceName, "host", "www.example.org"),
+					resource.TestCheckResourceAttr(resourceName, "type", "udp"),
+					resource.TestCheckResourceAttr(resourceName, "port", "5060"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePingdomCheck_httpcustom(t *testing.T) {
+	resourceName := "pingdom_check.httpcustom"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	updatedName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPingdomCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePingdomCheckConfig_httpcustom(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "host", "www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "type", "httpcustom"),
+					resource.TestCheckResourceAttr(resourceName, "url", "/status.xml"),
+					resource.TestCheckResourceAttr(resourceName, "additionalurls.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourcePingdomCheckConfig_httpcustom_update(updatedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+					resource.TestCheckResourceAttr(resourceName, "type", "httpcustom"),
+					resource.TestCheckResourceAttr(resourceName, "url", "/health/status.xml"),
+					resource.TestCheckResourceAttr(resourceName, "encryption", "true"),
+					resource.TestCheckResourceAttr(resourceName, "port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "additionalurls.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "additionalurls.0", "www.example.org/status.xml"),
+					resource.TestCheckResourceAttr(resourceName, "additionalurls.1", "www.example.net/status.xml"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePingdomCheck_probeFilter(t *testing.T) {
+	resourceName := "pingdom_check.ping"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPingdomCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePingdomCheckConfig_probeFilter(name, `"NA", "EU"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "probe_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "probe_filter.0.regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "probe_filter.0.regions.*", "NA"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "probe_filter.0.regions.*", "EU"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourcePingdomCheckConfig_probeFilter(name, `"LATAM"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPingdomResourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "probe_filter.0.regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "probe_filter.0.regions.*", "LATAM"),
+				),
+			},
+		},
+	})
+}
+
+func TestNormaliseProbeFilters(t *testing.T) {
+	tests := []struct {
+		filters []string
+		want    string
+	}{
+		{nil, ""},
+		{[]string{"region: NA"}, "region:NA"},
+		{[]string{"region: NA", "region: EU"}, "region:EU,region:NA"},
+		{[]string{"region:APAC,region: LATAM"}, "region:APAC,region:LATAM"},
+		{[]string{""}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := normaliseProbeFilters(tt.filters); got != tt.want {
+			t.Errorf("normaliseProbeFilters(%q) = %q, want %q", tt.filters, got, tt.want)
+		}
+	}
+}
+
+func TestFlattenProbeFilters(t *testing.T) {
+	flattened := flattenProbeFilters([]string{"region: NA", "region: EU"})
+	if len(flattened) != 1 {
+		t.Fatalf("expected a single probe_filter block, got %d", len(flattened))
+	}
+
+	regions := flattened[0].(map[string]interface{})["regions"].(*schema.Set)
+	if regions.Len() != 2 || !regions.Contains("NA") || !regions.Contains("EU") {
+		t.Errorf("unexpected regions: %v", regions.List())
+	}
+
+	if got := expandProbeFilter(flattened); got != "region:EU,region:NA" {
+		t.Errorf("expandProbeFilter() = %q, want %q", got, "region:EU,region:NA")
+	}
+
+	if flattened := flattenProbeFilters(nil); len(flattened) != 0 {
+		t.Errorf("expected no probe_filter block, got %v", flattened)
+	}
+}
+
+func TestResourcePingdomCheckStateUpgradeV0(t *testing.T) {
+	tests := []struct {
+		tags string
+		want []interface{}
+	}{
+		{"", []interface{}{}},
+		{"a,b", []interface{}{"a", "b"}},
+		{" b , a,,b", []interface{}{"b", "a"}},
+	}
+
+	for _, tt := range tests {
+		state, err := upgradeTagsStringToSet(context.Background(), map[string]interface{}{"tags": tt.tags}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(state["tags"], tt.want) {
+			t.Errorf("upgrade of %q = %#v, want %#v", tt.tags, state["tags"], tt.want)
+		}
+	}
+}
+
+func TestResourcePingdomCheckV0Schemas(t *testing.T) {
+	for name, r := range map[string]*schema.Resource{
+		"pingdom_check":     resourcePingdomCheckV0(),
+		"pingdom_tms_check": resourcePingdomTmsCheckV0(),
+	} {
+		if err := r.InternalValidate(nil, true); err != nil {
+			t.Errorf("%s: invalid v0 schema: %s", name, err)
+		}
+		if got := r.Schema["tags"].Type; got != schema.TypeString {
+			t.Errorf("%s: v0 tags is %s, want a string", name, got)
+		}
+	}
+	if _, ok := resourcePingdomCheckV0().Schema["probe_filter"]; ok {
+		t.Error("the v0 check schema has attributes added after version 0")
+	}
+}
+
+func TestResourcePingdomCheckTagsValidation(t *testing.T) {
+	validate := tagsSchema().Elem.(*schema.Schema).ValidateFunc
+
+	for _, tag := range []string{"a", "service_payments", "team-1", "service:payments", strings.Repeat("x", 64)} {
+		if _, errs := validate(tag, "tags"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", tag, errs)
+		}
+	}
+	for _, tag := range []string{"", "a b", "a,b", strings.Repeat("x", 65)} {
+		if _, errs := validate(tag, "tags"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", tag)
+		}
+	}
+}
+
+func TestResourcePingdomCheckCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:   "http",
+			config: map[string]interface{}{"type": "http", "shouldcontain": "ok", "port": 443},
+		},
+		{
+			name:    "stringtosend on http",
+			config:  map[string]interface{}{"type": "http", "stringtosend": "ping"},
+			wantErr: `stringtosend: not supported for checks of type "http"`,
+		},
+		{
+			name:    "shouldcontain on dns",
+			config:  map[string]interface{}{"type": "dns", "expectedip": "1.2.3.4", "nameserver": "ns.example.com", "shouldcontain": "ok"},
+			wantErr: `shouldcontain: not supported for checks of type "dns"`,
+		},
+		{
+			name:    "expectedip on tcp",
+			config:  map[string]interface{}{"type": "tcp", "port": 80, "expectedip": "1.2.3.4"},
+			wantErr: `expectedip: not supported for checks of type "tcp"`,
+		},
+		{
+			name:    "dns without nameserver",
+			config:  map[string]interface{}{"type": "dns", "expectedip": "1.2.3.4"},
+			wantErr: `nameserver: required for checks of type "dns"`,
+		},
+		{
+			name:    "udp without strings",
+			config:  map[string]interface{}{"type": "udp", "port": 53},
+			wantErr: `stringtoexpect: required for checks of type "udp"; stringtosend: required for checks of type "udp"`,
+		},
+		{
+			name:    "port on ping",
+			config:  map[string]interface{}{"type": "ping", "port": 80},
+			wantErr: `port: not supported for checks of type "ping"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"name": "test",
+				"host": "www.example.com",
+			}
+			for k, v := range tt.config {
+				raw[k] = v
+			}
+
+			_, err := resourcePingdomCheck().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSplitSensitiveRequestHeaders(t *testing.T) {
+	headers := map[string]string{
+		"Authorization": "Bearer secret",
+		"cookie":        "session=secret",
+		"X-Api-Key":     "key",
+		"X-Secret":      "secret",
+		"X-Test-Data":   "test",
+	}
+
+	tests := []struct {
+		name           string
+		plainState     map[string]interface{}
+		sensitiveState map[string]interface{}
+		wantPlain      map[string]string
+		wantSecret     map[string]string
+	}{
+		{
+			name:       "import",
+			wantPlain:  map[string]string{"X-Secret": "secret", "X-Test-Data": "test"},
+			wantSecret: map[string]string{"Authorization": "Bearer secret", "cookie": "session=secret", "X-Api-Key": "key"},
+		},
+		{
+			name:           "state",
+			plainState:     map[string]interface{}{"X-Api-Key": "old", "X-Test-Data": "old"},
+			sensitiveState: map[string]interface{}{"Authorization": "old", "X-Secret": "old"},
+			wantPlain:      map[string]string{"X-Api-Key": "key", "X-Test-Data": "test"},
+			wantSecret:     map[string]string{"Authorization": "Bearer secret", "cookie": "session=secret", "X-Secret": "secret"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plain, secret := splitSensitiveRequestHeaders(headers, tt.plainState, tt.sensitiveState)
+			if !reflect.DeepEqual(plain, tt.wantPlain) {
+				t.Errorf("plain headers = %v, want %v", plain, tt.wantPlain)
+			}
+			if !reflect.DeepEqual(secret, tt.wantSecret) {
+				t.Errorf("sensitive headers = %v, want %v", secret, tt.wantSecret)
+			}
+		})
+	}
+}
+
+func TestDuplicateRequestHeaders(t *testing.T) {
+	headers := map[string]interface{}{"Authorization": "a", "X-Api-Key": "b", "X-Test-Data": "c"}
+	sensitive := map[string]interface{}{"X-Api-Key": "d", "Authorization": "e", "X-Secret": "f"}
+
+	if got, want := duplicateRequestHeaders(headers, sensitive), []string{"Authorization", "X-Api-Key"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("duplicateRequestHeaders() = %v, want %v", got, want)
+	}
+	if got := duplicateRequestHeaders(headers, nil); len(got) != 0 {
+		t.Errorf("duplicateRequestHeaders() = %v, want none", got)
+	}
+}
+
 func testAccCheckPingdomCheckDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*Clients).Pingdom
 
@@ -338,7 +793,7 @@ resource "pingdom_check" "http" {
 	url                      = "/test"
 	port                     = 443
 	postdata                 = "test message"
-	tags                     = "a,b"
+	tags                     = ["a", "b"]
 	probefilters             = "region:APAC"
 	shouldnotcontain         = "shouldnotcontain"
 	username                 = "user"
@@ -350,6 +805,103 @@ resource "pingdom_check" "http" {
 	requestheaders = {
 		X-Test-Data = "test"
 	}
+	sensitive_requestheaders = {
+		Authorization = "Bearer secret"
+	}
 }
 `, name, name, name)
 }
+
+func testAccResourcePingdomCheckConfig_smtp(name string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "smtp" {
+	name = "%s"
+	host = "smtp.example.com"
+	port = 25
+	type = "smtp"
+}
+`, name)
+}
+
+func testAccResourcePingdomCheckConfig_smtp_update(name string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "smtp" {
+	name           = "%s"
+	host           = "smtp.example.org"
+	port           = 465
+	type           = "smtp"
+	encryption     = true
+	username       = "user"
+	password       = "password"
+	stringtoexpect = "220"
+}
+`, name)
+}
+
+func testAccResourcePingdomCheckConfig_mailbox(checkType string, name string, port int) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "%[1]s" {
+	name           = "%[2]s"
+	host           = "mail.example.com"
+	port           = %[3]d
+	type           = "%[1]s"
+	encryption     = true
+	stringtoexpect = "+OK"
+}
+`, checkType, name, port)
+}
+
+func testAccResourcePingdomCheckConfig_udp(name string, host string, port int) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "udp" {
+	name           = "%s"
+	host           = "%s"
+	port           = %d
+	type           = "udp"
+	stringtosend   = "ping"
+	stringtoexpect = "pong"
+}
+`, name, host, port)
+}
+
+func testAccResourcePingdomCheckConfig_httpcustom(name string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "httpcustom" {
+	name = "%s"
+	host = "www.example.com"
+	type = "httpcustom"
+	url  = "/status.xml"
+}
+`, name)
+}
+
+func testAccResourcePingdomCheckConfig_httpcustom_update(name string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "httpcustom" {
+	name       = "%s"
+	host       = "www.example.com"
+	type       = "httpcustom"
+	url        = "/health/status.xml"
+	encryption = true
+	port       = 443
+	additionalurls = [
+		"www.example.org/status.xml",
+		"www.example.net/status.xml",
+	]
+}
+`, name)
+}
+
+func testAccResourcePingdomCheckConfig_probeFilter(name string, regions string) string {
+	return fmt.Sprintf(`
+resource "pingdom_check" "ping" {
+	name = "%s"
+	host = "www.example.com"
+	type = "ping"
+
+	probe_filter {
+		regions = [%s]
+	}
+}
+`, name, regions)
+}
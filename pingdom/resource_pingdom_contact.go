@@ -18,7 +18,7 @@ func resourcePingdomContact() *schema.Resource {
 		UpdateContext: resourcePingdomContactUpdate,
 		DeleteContext: resourcePingdomContactDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourcePingdomContactImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -150,6 +150,18 @@ func contactForResource(d *schema.ResourceData) (*pingdom.Contact, error) {
 }
 
 func updateResourceFromContactResponse(d *schema.ResourceData, c *pingdom.Contact) error {
+	for k, v := range flattenContact(c) {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flattenContact maps the paused state and notification targets of a contact
+// to the contact attributes.
+func flattenContact(c *pingdom.Contact) map[string]interface{} {
 	smsTargets := []map[string]string{}
 	for _, raw := range c.NotificationTargets.SMS {
 		sms := map[string]string{
@@ -160,9 +172,6 @@ func updateResourceFromContactResponse(d *schema.ResourceData, c *pingdom.Contac
 		}
 		smsTargets = append(smsTargets, sms)
 	}
-	if err := d.Set("sms_notification", smsTargets); err != nil {
-		return err
-	}
 
 	emailTargets := []map[string]string{}
 	for _, raw := range c.NotificationTargets.Email {
@@ -172,15 +181,12 @@ func updateResourceFromContactResponse(d *schema.ResourceData, c *pingdom.Contac
 		}
 		emailTargets = append(emailTargets, email)
 	}
-	if err := d.Set("email_notification", emailTargets); err != nil {
-		return err
-	}
 
-	if err := d.Set("paused", c.Paused); err != nil {
-		return err
+	return map[string]interface{}{
+		"sms_notification":   smsTargets,
+		"email_notification": emailTargets,
+		"paused":             c.Paused,
 	}
-
-	return nil
 }
 
 func resourcePingdomContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -256,3 +262,28 @@ func resourcePingdomContactDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 	return nil
 }
+
+// resourcePingdomContactImport imports a contact by ID or by `name:<value>`.
+func resourcePingdomContactImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, ok := importLookupValue(d.Id(), "name")
+	if !ok {
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	contacts, err := meta.(*Clients).Pingdom.Contacts.List()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving list of contacts: %s", err)
+	}
+	names := make(map[int]string, len(contacts))
+	for _, contact := range contacts {
+		names[contact.ID] = contact.Name
+	}
+
+	id, err := findImportID("contact", "name", name, names)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
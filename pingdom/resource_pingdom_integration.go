@@ -23,7 +23,7 @@ func resourcePingdomIntegration() *schema.Resource {
 		UpdateContext: resourcePingdomIntegrationUpdate,
 		DeleteContext: resourcePingdomIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourcePingdomIntegrationImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"provider_name": {
@@ -84,7 +84,10 @@ func integrationForResource(d *schema.ResourceData, client *pingdomext.Client) (
 }
 
 func resourcePingdomIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).PingdomExt
+	client, err := meta.(*Clients).PingdomExt("pingdom_integration")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	integration, err := integrationForResource(d, client)
 	if err != nil {
@@ -96,6 +99,7 @@ func resourcePingdomIntegrationCreate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	meta.(*Clients).integrations.Invalidate()
 
 	if !result.Status {
 		return diag.Errorf("Integration create failed.")
@@ -106,7 +110,10 @@ func resourcePingdomIntegrationCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourcePingdomIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).PingdomExt
+	client, err := meta.(*Clients).PingdomExt("pingdom_integration")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -131,27 +138,23 @@ func resourcePingdomIntegrationUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourcePingdomIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).PingdomExt
+	client, err := meta.(*Clients).PingdomExt("pingdom_integration")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	integrations, err := client.Integrations.List()
+	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return diag.Errorf("Error retrieving list of integrations: %s", err)
+		return diag.Errorf("Error retrieving id for resource: %s", err)
 	}
-	exists := false
-	for _, integration := range integrations {
-		if strconv.Itoa(integration.ID) == d.Id() {
-			exists = true
-			break
-		}
+	exists, err := meta.(*Clients).integrations.Exists(id)
+	if err != nil {
+		return diag.Errorf("Error retrieving list of integrations: %s", err)
 	}
 	if !exists {
 		d.SetId("")
 		return nil
 	}
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.Errorf("Error retrieving id for resource: %s", err)
-	}
 	integration, err := client.Integrations.Read(id)
 	if err != nil {
 		return diag.Errorf("Error retrieving integration: %s", err)
@@ -178,7 +181,10 @@ func resourcePingdomIntegrationRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourcePingdomIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).PingdomExt
+	client, err := meta.(*Clients).PingdomExt("pingdom_integration")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.Errorf("Error retrieving id for resource: %s", err)
@@ -188,6 +194,7 @@ func resourcePingdomIntegrationDelete(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.Errorf("Error deleting integration: %s", err)
 	}
+	meta.(*Clients).integrations.Invalidate()
 
 	if !result.Status {
 		return diag.Errorf("Integration delete failed.")
@@ -208,3 +215,32 @@ func getIntegrationProvider(providerName string, client *pingdomext.Client) (*pi
 	}
 	return nil, fmt.Errorf("Unable find the integration provider %s", providerName)
 }
+
+// resourcePingdomIntegrationImport imports a integration by ID or by `name:<value>`.
+func resourcePingdomIntegrationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, ok := importLookupValue(d.Id(), "name")
+	if !ok {
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	client, err := meta.(*Clients).PingdomExt("pingdom_integration")
+	if err != nil {
+		return nil, err
+	}
+	integrations, err := client.Integrations.List()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving list of integrations: %s", err)
+	}
+	names := make(map[int]string, len(integrations))
+	for _, integration := range integrations {
+		names[integration.ID] = integration.UserData["name"]
+	}
+
+	id, err := findImportID("integration", "name", name, names)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -79,7 +79,10 @@ func TestAccPingdomIntegration_update(t *testing.T) {
 }
 
 func testAccCheckPingdomIntegrationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*Clients).PingdomExt
+	client, err := testAccProvider.Meta().(*Clients).PingdomExt("pingdom_integration")
+	if err != nil {
+		return err
+	}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "pingdom_integration" {
@@ -114,7 +117,10 @@ func testAccCheckPingdomIntegrationExists(n string, obj *pingdomext.IntegrationG
 			return fmt.Errorf("No ID is set")
 		}
 
-		client := testAccProvider.Meta().(*Clients).PingdomExt
+		client, err := testAccProvider.Meta().(*Clients).PingdomExt("pingdom_integration")
+		if err != nil {
+			return err
+		}
 
 		resID, err := strconv.Atoi(rs.Primary.ID)
 
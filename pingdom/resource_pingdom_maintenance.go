@@ -2,6 +2,7 @@ package pingdom
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nordcloud/go-pingdom/pingdom"
@@ -16,45 +17,49 @@ func resourcePingdomMaintenance() *schema.Resource {
 		UpdateContext: resourcePingdomMaintenanceUpdate,
 		DeleteContext: resourcePingdomMaintenanceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourcePingdomMaintenanceImport,
 		},
-		Schema: map[string]*schema.Schema{
-			"description": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"from": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"to": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"effectiveto": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"recurrencetype": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "none",
-			},
-			"repeatevery": {
-				Type:     schema.TypeInt,
-				Optional: true,
-			},
-			"tmsids": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeInt},
-			},
-			"uptimeids": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeInt},
-			},
+		Schema: resourcePingdomMaintenanceSchema(),
+	}
+}
+
+func resourcePingdomMaintenanceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"from": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"to": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"effectiveto": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"recurrencetype": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "none",
+		},
+		"repeatevery": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"tmsids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"uptimeids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
 		},
 	}
 }
@@ -105,53 +110,34 @@ func maintenanceForResource(d *schema.ResourceData) (*pingdom.MaintenanceWindow,
 }
 
 func updateResourceFromMaintenanceResponse(d *schema.ResourceData, m *pingdom.MaintenanceResponse) error {
-	if err := d.Set("description", m.Description); err != nil {
-		return err
-	}
-
-	if err := d.Set("from", timeFormat(m.From)); err != nil {
-		return err
-	}
-
-	if err := d.Set("to", timeFormat(m.To)); err != nil {
-		return err
-	}
-
-	if err := d.Set("effectiveto", timeFormat(m.EffectiveTo)); err != nil {
-		return err
-	}
-
-	if err := d.Set("recurrencetype", m.RecurrenceType); err != nil {
-		return err
-	}
-
-	if err := d.Set("repeatevery", m.RepeatEvery); err != nil {
-		return err
+	for k, v := range flattenMaintenance(m) {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
 	}
 
-	tmsids := schema.NewSet(
-		func(tmsId interface{}) int { return tmsId.(int) },
-		[]interface{}{},
-	)
-	for _, tms := range m.Checks.Tms {
-		tmsids.Add(tms)
-	}
-	if err := d.Set("tmsids", tmsids); err != nil {
-		return err
-	}
+	return nil
+}
 
-	uptimeids := schema.NewSet(
-		func(uptimeId interface{}) int { return uptimeId.(int) },
-		[]interface{}{},
-	)
-	for _, uptime := range m.Checks.Uptime {
-		uptimeids.Add(uptime)
-	}
-	if err := d.Set("uptimeids", uptimeids); err != nil {
-		return err
+// flattenMaintenance maps a maintenance window response to the maintenance
+// attributes.
+func flattenMaintenance(m *pingdom.MaintenanceResponse) map[string]interface{} {
+	tmsids := make([]int, len(m.Checks.Tms))
+	copy(tmsids, m.Checks.Tms)
+
+	uptimeids := make([]int, len(m.Checks.Uptime))
+	copy(uptimeids, m.Checks.Uptime)
+
+	return map[string]interface{}{
+		"description":    m.Description,
+		"from":           timeFormat(m.From),
+		"to":             timeFormat(m.To),
+		"effectiveto":    timeFormat(m.EffectiveTo),
+		"recurrencetype": m.RecurrenceType,
+		"repeatevery":    m.RepeatEvery,
+		"tmsids":         tmsids,
+		"uptimeids":      uptimeids,
 	}
-
-	return nil
 }
 
 func resourcePingdomMaintenanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -229,3 +215,28 @@ func convertIntInterfaceSliceToString(slice []interface{}) string {
 	}
 	return strings.Join(stringSlice, ",")
 }
+
+// resourcePingdomMaintenanceImport imports a maintenance window by ID or by `description:<value>`.
+func resourcePingdomMaintenanceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	description, ok := importLookupValue(d.Id(), "description")
+	if !ok {
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	maintenances, err := meta.(*Clients).Pingdom.Maintenances.List()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving list of maintenance windows: %s", err)
+	}
+	descriptions := make(map[int]string, len(maintenances))
+	for _, maintenance := range maintenances {
+		descriptions[maintenance.ID] = maintenance.Description
+	}
+
+	id, err := findImportID("maintenance window", "description", description, descriptions)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
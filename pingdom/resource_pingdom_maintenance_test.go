@@ -37,6 +37,12 @@ func TestAccResourcePingdomMaintenance_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "description:" + description,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccResourcePingdomMaintenanceConfigUpdate(updatedDescription),
 				Check: resource.ComposeAggregateTestCheckFunc(
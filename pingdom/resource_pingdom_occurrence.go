@@ -65,6 +65,15 @@ func timeFormat(unixTime int64) string {
 	return time.Unix(unixTime, 0).Format(time.RFC3339)
 }
 
+// optionalTimeFormat formats the timestamp like timeFormat, but leaves unset
+// (zero) timestamps empty.
+func optionalTimeFormat(unixTime int64) string {
+	if unixTime == 0 {
+		return ""
+	}
+	return timeFormat(unixTime)
+}
+
 func getTime(attr string, d *schema.ResourceData) (int64, bool, error) {
 	v, ok := d.GetOk(attr)
 	if ok {
@@ -2,6 +2,7 @@ package pingdom
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 
@@ -17,7 +18,7 @@ func resourcePingdomTeam() *schema.Resource {
 		UpdateContext: resourcePingdomTeamUpdate,
 		DeleteContext: resourcePingdomTeamDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourcePingdomTeamImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -68,6 +69,7 @@ func resourcePingdomTeamCreate(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	meta.(*Clients).teams.Invalidate()
 
 	d.SetId(strconv.Itoa(result.ID))
 	return nil
@@ -76,25 +78,18 @@ func resourcePingdomTeamCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourcePingdomTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Clients).Pingdom
 
-	teams, err := client.Teams.List()
+	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return diag.Errorf("Error retrieving list of teams: %s", err)
+		return diag.Errorf("Error retrieving id for resource: %s", err)
 	}
-	exists := false
-	for _, team := range teams {
-		if strconv.Itoa(team.ID) == d.Id() {
-			exists = true
-			break
-		}
+	exists, err := meta.(*Clients).teams.Exists(id)
+	if err != nil {
+		return diag.Errorf("Error retrieving list of teams: %s", err)
 	}
 	if !exists {
 		d.SetId("")
 		return nil
 	}
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.Errorf("Error retrieving id for resource: %s", err)
-	}
 	team, err := client.Teams.Read(id)
 	if err != nil {
 		return diag.Errorf("Error retrieving team: %s", err)
@@ -149,6 +144,32 @@ func resourcePingdomTeamDelete(ctx context.Context, d *schema.ResourceData, meta
 	if _, err = client.Teams.Delete(id); err != nil {
 		return diag.Errorf("Error deleting team: %s", err)
 	}
+	meta.(*Clients).teams.Invalidate()
 
 	return nil
 }
+
+// resourcePingdomTeamImport imports a team by ID or by `name:<value>`.
+func resourcePingdomTeamImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, ok := importLookupValue(d.Id(), "name")
+	if !ok {
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	teams, err := meta.(*Clients).Pingdom.Teams.List()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving list of teams: %s", err)
+	}
+	names := make(map[int]string, len(teams))
+	for _, team := range teams {
+		names[team.ID] = team.Name
+	}
+
+	id, err := findImportID("team", "name", name, names)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -38,6 +38,12 @@ func TestAccResourcePingdomTeam_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccResourcePingdomTeamConfigUpdate(updatedName),
 				Check: resource.ComposeAggregateTestCheckFunc(
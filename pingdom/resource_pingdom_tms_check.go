@@ -2,13 +2,13 @@ package pingdom
 
 import (
 	"context"
+	"fmt"
 	"log"
-	"sort"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nordcloud/go-pingdom/pingdom"
 )
 
@@ -18,10 +18,127 @@ func resourcePingdomTmsCheck() *schema.Resource {
 		ReadContext:   resourcePingdomTmsCheckRead,
 		UpdateContext: resourcePingdomTmsCheckUpdate,
 		DeleteContext: resourcePingdomTmsCheckDelete,
+		CustomizeDiff: customizeDiffReserveTmsCheckCredit,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourcePingdomTmsCheckImport,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourcePingdomTmsCheckV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeTagsStringToSet,
+				Version: 0,
+			},
+		},
+		Schema: resourcePingdomTmsCheckSchema(),
+	}
+}
 
+func resourcePingdomTmsCheckSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"steps": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"fn": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"args": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"active": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"contact_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"custom_message": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"integration_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"interval": { //  [5 10 20 60 720 1440]
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
+		},
+		"metadata": {
+			Type:     schema.TypeList,
+			MinItems: 0,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"authentication": {
+						Type:      schema.TypeMap,
+						Optional:  true,
+						Sensitive: true,
+						Elem:      &schema.Schema{Type: schema.TypeString},
+					},
+					"disable_websecurity": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"height": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"width": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"region": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "us-east",
+			ValidateFunc: validation.StringInSlice(tmsCheckRegions, false),
+		},
+		"send_notification_when_down": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"security_level": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "high",
+		},
+		"tags": tagsSchema(),
+		"team_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+	}
+}
+
+// tmsCheckRegions are the regions a TMS check can run from.
+var tmsCheckRegions = []string{"us-east", "us-west", "eu", "au"}
+
+// resourcePingdomTmsCheckV0 is the TMS check schema before tags became a set.
+// It's a frozen copy, only its type matters to the state upgrade.
+func resourcePingdomTmsCheckV0() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -62,14 +179,12 @@ func resourcePingdomTmsCheck() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-			"interval": { //  [5 10 20 60 720 1440]
+			"interval": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				Default:  10,
 			},
 			"metadata": {
 				Type:     schema.TypeList,
-				MinItems: 0,
 				MaxItems: 1,
 				Optional: true,
 				Elem: &schema.Resource{
@@ -97,7 +212,6 @@ func resourcePingdomTmsCheck() *schema.Resource {
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "us-east",
 			},
 			"send_notification_when_down": {
 				Type:     schema.TypeInt,
@@ -106,14 +220,10 @@ func resourcePingdomTmsCheck() *schema.Resource {
 			"security_level": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "high",
 			},
 			"tags": {
 				Type:     schema.TypeString,
 				Optional: true,
-				StateFunc: func(val interface{}) string {
-					return sortString(val.(string), ",")
-				},
 			},
 			"team_ids": {
 				Type:     schema.TypeSet,
@@ -251,9 +361,7 @@ func toTmsCheck(d *schema.ResourceData) (*pingdom.TMSCheck, error) {
 	}
 
 	if v, ok := d.GetOk("tags"); ok {
-		list := strings.Split(v.(string), ",")
-		sort.Strings(list)
-		tmsCheck.Tags = list
+		tmsCheck.Tags = expandTags(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("team_ids"); ok {
@@ -272,12 +380,14 @@ func resourcePingdomTmsCheckCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] TMS Check create configuration: %#v, %#v", d.Get("name"), d.Get("steps"))
+	// The step arguments may hold credentials, only the name is logged.
+	log.Printf("[DEBUG] TMS Check create configuration: %#v", d.Get("name"))
 
 	ck, err := client.TMSCheck.Create(check)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	meta.(*Clients).tmsChecks.Invalidate()
 
 	d.SetId(strconv.Itoa(ck.ID))
 
@@ -291,17 +401,10 @@ func resourcePingdomTmsCheckRead(ctx context.Context, d *schema.ResourceData, me
 	if err != nil {
 		return diag.Errorf("Error retrieving id for TMS check: %s", err)
 	}
-	cl, err := client.TMSCheck.List()
+	exists, err := meta.(*Clients).tmsChecks.Exists(id)
 	if err != nil {
 		return diag.Errorf("Error retrieving list of TMS checks: %s", err)
 	}
-	exists := false
-	for _, ckid := range cl {
-		if ckid.ID == id {
-			exists = true
-			break
-		}
-	}
 	if !exists {
 		d.SetId("")
 		return nil
@@ -311,10 +414,20 @@ func resourcePingdomTmsCheckRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("Error retrieving TMS check: %s", err)
 	}
 
-	if err := d.Set("name", ck.Name); err != nil {
+	if err := updateResourceFromTmsCheckResponse(d, ck); err != nil {
 		return diag.FromErr(err)
 	}
 
+	return nil
+}
+
+// updateResourceFromTmsCheckResponse maps a TMS check response to the TMS check
+// attributes. It is shared with the pingdom_tms_check data source.
+func updateResourceFromTmsCheckResponse(d *schema.ResourceData, ck *pingdom.TMSCheckDetailResponse) error {
+	if err := d.Set("name", ck.Name); err != nil {
+		return err
+	}
+
 	steps := make([]map[string]interface{}, 0, len(ck.Steps))
 	for _, stepObj := range ck.Steps {
 		step := map[string]interface{}{}
@@ -337,9 +450,10 @@ func resourcePingdomTmsCheckRead(ctx context.Context, d *schema.ResourceData, me
 		metadata = append(metadata, m)
 	}
 
-	// We need to sort the strings here as the pingdom API returns them sorted by
-	//number of occurances across all checks
-	sort.Strings(ck.Tags)
+	tags := schema.NewSet(schema.HashString, []interface{}{})
+	for _, tag := range ck.Tags {
+		tags.Add(tag)
+	}
 
 	for k, v := range map[string]interface{}{
 		"name":                        ck.Name,
@@ -353,11 +467,11 @@ func resourcePingdomTmsCheckRead(ctx context.Context, d *schema.ResourceData, me
 		"region":                      ck.Region,
 		"send_notification_when_down": ck.SendNotificationWhenDown,
 		"security_level":              ck.SeverityLevel,
-		"tags":                        strings.Join(ck.Tags, ","),
+		"tags":                        tags,
 		"team_ids":                    convertIntSliceToTypeSet(ck.TeamIDs),
 	} {
 		if err := d.Set(k, v); err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
@@ -399,6 +513,32 @@ func resourcePingdomTmsCheckDelete(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.Errorf("Error deleting TMS check: %s", err)
 	}
+	meta.(*Clients).tmsChecks.Invalidate()
 
 	return nil
 }
+
+// resourcePingdomTmsCheckImport imports a TMS check by ID or by `name:<value>`.
+func resourcePingdomTmsCheckImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, ok := importLookupValue(d.Id(), "name")
+	if !ok {
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	checks, err := meta.(*Clients).Pingdom.TMSCheck.List()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving list of TMS checks: %s", err)
+	}
+	names := make(map[int]string, len(checks))
+	for _, check := range checks {
+		names[check.ID] = check.Name
+	}
+
+	id, err := findImportID("TMS check", "name", name, names)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
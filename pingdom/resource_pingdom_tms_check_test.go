@@ -35,7 +35,7 @@ func TestAccResourcePingdomTmsCheck(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "interval", "10"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "region", "us-east"),
-					resource.TestCheckResourceAttr(resourceName, "tags", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "teamids.#", "0"),
 				),
 			},
@@ -57,7 +57,9 @@ func TestAccResourcePingdomTmsCheck(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "interval", "20"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "region", "us-east"),
-					resource.TestCheckResourceAttr(resourceName, "tags", "bar,foo"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "bar"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "foo"),
 					resource.TestCheckResourceAttr(resourceName, "team_ids.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.0", contactResourceName, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "team_ids.0", teamResourceName, "id"),
@@ -139,7 +141,7 @@ resource "pingdom_tms_check" "test" {
   region = "us-east"
   send_notification_when_down = 1
   security_level = "high"
-  tags = "foo,bar"
+  tags = ["foo", "bar"]
   team_ids = [
 	pingdom_team.test.id
   ]
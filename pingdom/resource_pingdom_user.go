@@ -3,6 +3,7 @@ package pingdom
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +23,7 @@ func resourceSolarwindsUser() *schema.Resource {
 		UpdateContext: resourceSolarwindsUserUpdate,
 		DeleteContext: resourceSolarwindsUserDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSolarwindsUserImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"email": {
@@ -101,7 +102,10 @@ func expandUserProducts(l []interface{}) []solarwinds.Product {
 }
 
 func resourceSolarwindsUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).Solarwinds
+	client, err := meta.(*Clients).Solarwinds("pingdom_user")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	user, err := userFromResource(d)
 	if err != nil {
@@ -119,7 +123,10 @@ func resourceSolarwindsUserCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSolarwindsUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).Solarwinds
+	client, err := meta.(*Clients).Solarwinds("pingdom_user")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	email := d.Id()
 	user, err := client.UserService.Retrieve(email)
@@ -161,7 +168,10 @@ func flattenUserProducts(l []solarwinds.Product) []interface{} {
 }
 
 func resourceSolarwindsUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).Solarwinds
+	client, err := meta.(*Clients).Solarwinds("pingdom_user")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChanges("role", "products") {
 		user, err := userFromResource(d)
 		if err != nil {
@@ -179,10 +189,13 @@ func resourceSolarwindsUserUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSolarwindsUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).Solarwinds
+	client, err := meta.(*Clients).Solarwinds("pingdom_user")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	id := d.Id()
-	err := resource.RetryContext(ctx, DeleteUserRetryTimeout, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, DeleteUserRetryTimeout, func() *resource.RetryError {
 		if err := client.UserService.Delete(id); err != nil {
 			var clientErr *solarwinds.ClientError
 			ok := errors.As(err, &clientErr)
@@ -200,3 +213,28 @@ func resourceSolarwindsUserDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// resourceSolarwindsUserImport imports a user, active or invited, by email.
+// The `email:<value>` form is accepted as well.
+func resourceSolarwindsUserImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Clients).Solarwinds("pingdom_user")
+	if err != nil {
+		return nil, err
+	}
+
+	email, ok := importLookupValue(d.Id(), "email")
+	if !ok {
+		email = d.Id()
+	}
+
+	user, err := client.UserService.Retrieve(email)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving user with email %v: %s", email, err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("no user found with email %q", email)
+	}
+	d.SetId(user.Email)
+
+	return []*schema.ResourceData{d}, nil
+}
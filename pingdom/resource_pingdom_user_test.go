@@ -110,7 +110,10 @@ func TestAccUser_update(t *testing.T) {
 }
 
 func testAccCheckUserDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*Clients).Solarwinds
+	client, err := testAccProvider.Meta().(*Clients).Solarwinds("pingdom_user")
+	if err != nil {
+		return err
+	}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "pingdom_user" {
@@ -141,7 +144,10 @@ func testAccCheckUserExist(n string) resource.TestCheckFunc {
 		}
 
 		email := rs.Primary.ID
-		client := testAccProvider.Meta().(*Clients).Solarwinds
+		client, err := testAccProvider.Meta().(*Clients).Solarwinds("pingdom_user")
+		if err != nil {
+			return err
+		}
 		user, err := client.UserService.Retrieve(email)
 		if err != nil {
 			return err
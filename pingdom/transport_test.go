@@ -0,0 +1,176 @@
+package pingdom
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		statuses  []int
+		wantCalls int
+		wantCode  int
+	}{
+		{name: "success", method: "GET", statuses: []int{200}, wantCalls: 1, wantCode: 200},
+		{name: "throttled", method: "GET", statuses: []int{429, 503, 200}, wantCalls: 3, wantCode: 200},
+		{name: "bad gateway", method: "DELETE", statuses: []int{502, 200}, wantCalls: 2, wantCode: 200},
+		{name: "client error", method: "GET", statuses: []int{404}, wantCalls: 1, wantCode: 404},
+		{name: "server error", method: "GET", statuses: []int{500}, wantCalls: 1, wantCode: 500},
+		{name: "exhausted", method: "GET", statuses: []int{503, 503, 503, 503}, wantCalls: 3, wantCode: 503},
+		{name: "post throttled", method: "POST", statuses: []int{429, 200}, wantCalls: 2, wantCode: 200},
+		{name: "post unavailable", method: "POST", statuses: []int{503, 200}, wantCalls: 1, wantCode: 503},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "POST" {
+					body, err := ioutil.ReadAll(r.Body)
+					if err != nil || string(body) != "name=a" {
+						t.Errorf("attempt %d: body %q, %v", calls, body, err)
+					}
+				}
+				w.WriteHeader(tt.statuses[calls])
+				calls++
+			}))
+			defer server.Close()
+
+			var waits []time.Duration
+			transport := newRetryTransport(http.DefaultTransport, 2, 10*time.Second)
+			transport.sleep = func(req *http.Request, d time.Duration) error {
+				waits = append(waits, d)
+				return nil
+			}
+
+			req, err := http.NewRequest(tt.method, server.URL, nil)
+			if tt.method == "POST" {
+				req, err = http.NewRequest(tt.method, server.URL, strings.NewReader("name=a"))
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := (&http.Client{Transport: transport}).Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantCode)
+			}
+			for i, wait := range waits {
+				if max := minRetryWait << uint(i); wait < max/2 || wait > max {
+					t.Errorf("wait %d = %s, want between %s and %s", i, wait, max/2, max)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransportRetryAfter(t *testing.T) {
+	tests := []struct {
+		retryAfter string
+		wantCalls  int
+		wantWait   time.Duration
+	}{
+		{retryAfter: "3", wantCalls: 2, wantWait: 3 * time.Second},
+		{retryAfter: "0", wantCalls: 2, wantWait: 0},
+		{retryAfter: "60", wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", tt.retryAfter)
+				w.WriteHeader(http.StatusTooManyRequests)
+			}
+		}))
+
+		var waits []time.Duration
+		transport := newRetryTransport(http.DefaultTransport, 2, 10*time.Second)
+		transport.sleep = func(req *http.Request, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		}
+
+		resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.retryAfter, err)
+		}
+		resp.Body.Close()
+
+		if calls != tt.wantCalls {
+			t.Errorf("%s: calls = %d, want %d", tt.retryAfter, calls, tt.wantCalls)
+		}
+		if len(waits) > 0 && waits[0] != tt.wantWait {
+			t.Errorf("%s: wait = %s, want %s", tt.retryAfter, waits[0], tt.wantWait)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{value: "", wantOk: false},
+		{value: "120", want: 2 * time.Minute, wantOk: true},
+		{value: "-1", wantOk: false},
+		{value: "soon", wantOk: false},
+		{value: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0, wantOk: true},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("parseRetryAfter(%q) = %s, %t, want %s, %t", tt.value, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestRetryTransportLeavesRequestUnchanged(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	transport := newRetryTransport(http.DefaultTransport, 2, 10*time.Second)
+	transport.sleep = func(req *http.Request, d time.Duration) error { return nil }
+
+	req, err := http.NewRequest("PUT", server.URL, strings.NewReader("name=a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := req.Body
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+	if req.Body != body {
+		t.Error("the request body was replaced")
+	}
+}
@@ -4,6 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"hash/crc32"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nordcloud/go-pingdom/pingdom"
 )
 
 // String hashes a string to a unique hashcode.
@@ -33,3 +38,49 @@ func Strings(strings []string) string {
 
 	return fmt.Sprintf("%d", String(buf.String()))
 }
+
+// filterByID returns the indexes of the n listed items accepted by match,
+// ordered by the item IDs, as the list data sources return their results. A
+// nil match accepts all the items.
+func filterByID(n int, id func(i int) int, match func(i int) bool) []int {
+	matches := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		if match == nil || match(i) {
+			matches = append(matches, i)
+		}
+	}
+	sort.Slice(matches, func(a, b int) bool { return id(matches[a]) < id(matches[b]) })
+	return matches
+}
+
+// listID returns the ID of a list data source, derived from the IDs listed so
+// that it only changes when the result does.
+func listID(ids []int) string {
+	idStrings := make([]string, 0, len(ids))
+	for _, id := range ids {
+		idStrings = append(idStrings, strconv.Itoa(id))
+	}
+	return Strings(idStrings)
+}
+
+// getOptionalBool returns the value of a bool attribute, or nil when it's not
+// set. GetOk can't tell an explicit false from an unset attribute.
+func getOptionalBool(d *schema.ResourceData, key string) *bool {
+	v, ok := d.GetOkExists(key)
+	if !ok {
+		return nil
+	}
+	b := v.(bool)
+	return &b
+}
+
+// getPingdomJSON decodes the response of a GET request to the Pingdom API into
+// v. It's used for the endpoints go-pingdom doesn't wrap.
+func getPingdomJSON(client *pingdom.Client, path string, params map[string]string, v interface{}) error {
+	req, err := client.NewRequest("GET", path, params)
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, v)
+	return err
+}
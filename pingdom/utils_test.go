@@ -0,0 +1,29 @@
+package pingdom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterByID(t *testing.T) {
+	ids := []int{30, 10, 20, 40}
+
+	matches := filterByID(len(ids), func(i int) int { return ids[i] }, func(i int) bool { return ids[i] != 20 })
+	if want := []int{1, 0, 3}; !reflect.DeepEqual(matches, want) {
+		t.Errorf("filterByID() = %v, want %v", matches, want)
+	}
+
+	matches = filterByID(len(ids), func(i int) int { return ids[i] }, nil)
+	if want := []int{1, 2, 0, 3}; !reflect.DeepEqual(matches, want) {
+		t.Errorf("filterByID() without match = %v, want %v", matches, want)
+	}
+}
+
+func TestListID(t *testing.T) {
+	if listID([]int{1, 2}) == listID([]int{1, 3}) {
+		t.Error("different IDs listed give the same ID")
+	}
+	if listID([]int{1, 2}) != listID([]int{1, 2}) {
+		t.Error("the same IDs listed give different IDs")
+	}
+}